@@ -2,7 +2,12 @@ package main
 
 import (
 	"budgeting-service/config"
+	pbu "budgeting-service/generated/user"
+	"budgeting-service/pkg/auth"
+	"budgeting-service/pkg/errs"
+	"budgeting-service/pkg/exchange"
 	"budgeting-service/pkg/logs"
+	"budgeting-service/pkg/validate"
 	"budgeting-service/queue/kafka/consumer"
 	"budgeting-service/service"
 	"budgeting-service/storage"
@@ -14,6 +19,7 @@ import (
 	"net"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
@@ -30,8 +36,29 @@ func main() {
 	log.Println("Initializing Redis connection...")
 	rdb := redis.ConnectToRedis(cfg)
 
+	log.Println("Migrating money fields to minor units...")
+	if err := mongodb.MigrateMoneyToMinorUnits(context.Background(), db); err != nil {
+		log.Fatalf("Error migrating money fields: %v", err)
+	}
+
+	log.Println("Ensuring MongoDB indexes...")
+	if err := mongodb.EnsureIndexes(context.Background(), db); err != nil {
+		log.Fatalf("Error creating indexes: %v", err)
+	}
+
 	storage := storage.NewStorage(rdb, db)
 
+	if cfg.ExchangeRatesFile != "" {
+		log.Println("Loading exchange rates from", cfg.ExchangeRatesFile)
+		rates, err := exchange.LoadFile(cfg.ExchangeRatesFile)
+		if err != nil {
+			log.Fatalf("Error loading exchange rates: %v", err)
+		}
+		if err := storage.ExchangeRateRepository().SaveRates(context.Background(), rates); err != nil {
+			log.Fatalf("Error saving exchange rates: %v", err)
+		}
+	}
+
 	listener, err := net.Listen("tcp",
 		fmt.Sprintf(":%d", cfg.GRPC_PORT),
 	)
@@ -39,7 +66,26 @@ func main() {
 		log.Fatalf("Error starting server: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	log.Println("Connecting to auth service...")
+	authConn, err := grpc.NewClient(cfg.AuthServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Error connecting to auth service: %v", err)
+	}
+	defer authConn.Close()
+	verifier := auth.NewAuthServiceVerifier(pbu.NewAuthServiceClient(authConn))
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			auth.UnaryServerInterceptor(verifier),
+			errs.UnaryServerInterceptor(logger),
+			validate.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			auth.StreamServerInterceptor(verifier),
+			errs.StreamServerInterceptor(logger),
+			validate.StreamServerInterceptor(),
+		),
+	)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -50,6 +96,15 @@ func main() {
 	go kafka.UpdateBudget(ctx, "budgets")
 	go kafka.SendNotification(ctx, "notifications")
 
+	scheduler := service.NewRecurringScheduler(storage, logger, cfg.RecurringInterval)
+	go scheduler.Run(ctx)
+
+	rollover := service.NewBudgetRollover(storage, logger, cfg.BudgetRolloverInterval)
+	go rollover.Run(ctx)
+
+	goalMonitor := service.NewGoalMonitor(storage, logger, cfg.GoalMonitorInterval)
+	go goalMonitor.Run(ctx)
+
 	service := service.NewServiceManager(listener, grpcServer)
 	service.RegisterServiceManagerServer(storage, logger)
 
@@ -3,6 +3,7 @@ package config
 import (
 	"log"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/cast"
@@ -17,6 +18,14 @@ type Config struct {
 	MONGODB_NAME   string   `yaml:"mongodb_name"`
 	MONGODB_URI    string   `yaml:"mongodb_uri"`
 	KafkaBrokers   []string `yaml:"kafka_brokers"`
+
+	ExchangeRatesFile string `yaml:"exchange_rates_file"`
+
+	AuthServiceAddr string `yaml:"auth_service_addr"`
+
+	RecurringInterval      time.Duration `yaml:"recurring_interval"`
+	BudgetRolloverInterval time.Duration `yaml:"budget_rollover_interval"`
+	GoalMonitorInterval    time.Duration `yaml:"goal_monitor_interval"`
 }
 
 func Load() *Config {
@@ -38,6 +47,14 @@ func Load() *Config {
 
 	config.KafkaBrokers = cast.ToStringSlice(coalesce("KAFKA_BROKERS", "localhost:9092"))
 
+	config.ExchangeRatesFile = cast.ToString(coalesce("EXCHANGE_RATES_FILE", ""))
+
+	config.AuthServiceAddr = cast.ToString(coalesce("AUTH_SERVICE_ADDR", "localhost:50050"))
+
+	config.RecurringInterval = cast.ToDuration(coalesce("RECURRING_INTERVAL", "1m"))
+	config.BudgetRolloverInterval = cast.ToDuration(coalesce("BUDGET_ROLLOVER_INTERVAL", "1h"))
+	config.GoalMonitorInterval = cast.ToDuration(coalesce("GOAL_MONITOR_INTERVAL", "6h"))
+
 	return config
 }
 
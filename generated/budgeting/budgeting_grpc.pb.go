@@ -24,6 +24,9 @@ const (
 	BudgetingService_GetCategory_FullMethodName       = "/budgeting.BudgetingService/GetCategory"
 	BudgetingService_UpdateCategory_FullMethodName    = "/budgeting.BudgetingService/UpdateCategory"
 	BudgetingService_DeleteCategory_FullMethodName    = "/budgeting.BudgetingService/DeleteCategory"
+	BudgetingService_SuggestCategory_FullMethodName   = "/budgeting.BudgetingService/SuggestCategory"
+	BudgetingService_MoveCategory_FullMethodName      = "/budgeting.BudgetingService/MoveCategory"
+	BudgetingService_MergeCategories_FullMethodName   = "/budgeting.BudgetingService/MergeCategories"
 	BudgetingService_CreateBudget_FullMethodName      = "/budgeting.BudgetingService/CreateBudget"
 	BudgetingService_GetBudgetsList_FullMethodName    = "/budgeting.BudgetingService/GetBudgetsList"
 	BudgetingService_GetBudget_FullMethodName         = "/budgeting.BudgetingService/GetBudget"
@@ -41,6 +44,9 @@ type BudgetingServiceClient interface {
 	GetCategory(ctx context.Context, in *GetCategoryReq, opts ...grpc.CallOption) (*GetCategoryResp, error)
 	UpdateCategory(ctx context.Context, in *UpdateCategoryReq, opts ...grpc.CallOption) (*UpdateCategoryResp, error)
 	DeleteCategory(ctx context.Context, in *DeleteCategoryReq, opts ...grpc.CallOption) (*DeleteCategoryResp, error)
+	SuggestCategory(ctx context.Context, in *SuggestCategoryReq, opts ...grpc.CallOption) (*SuggestCategoryResp, error)
+	MoveCategory(ctx context.Context, in *MoveCategoryReq, opts ...grpc.CallOption) (*MoveCategoryResp, error)
+	MergeCategories(ctx context.Context, in *MergeCategoriesReq, opts ...grpc.CallOption) (*MergeCategoriesResp, error)
 	// Byudjetni boshqarish
 	CreateBudget(ctx context.Context, in *CreateBudgetReq, opts ...grpc.CallOption) (*CreateBudgetResp, error)
 	GetBudgetsList(ctx context.Context, in *GetBudgetsReq, opts ...grpc.CallOption) (*GetBudgetsResp, error)
@@ -107,6 +113,36 @@ func (c *budgetingServiceClient) DeleteCategory(ctx context.Context, in *DeleteC
 	return out, nil
 }
 
+func (c *budgetingServiceClient) SuggestCategory(ctx context.Context, in *SuggestCategoryReq, opts ...grpc.CallOption) (*SuggestCategoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestCategoryResp)
+	err := c.cc.Invoke(ctx, BudgetingService_SuggestCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetingServiceClient) MoveCategory(ctx context.Context, in *MoveCategoryReq, opts ...grpc.CallOption) (*MoveCategoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveCategoryResp)
+	err := c.cc.Invoke(ctx, BudgetingService_MoveCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetingServiceClient) MergeCategories(ctx context.Context, in *MergeCategoriesReq, opts ...grpc.CallOption) (*MergeCategoriesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeCategoriesResp)
+	err := c.cc.Invoke(ctx, BudgetingService_MergeCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *budgetingServiceClient) CreateBudget(ctx context.Context, in *CreateBudgetReq, opts ...grpc.CallOption) (*CreateBudgetResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateBudgetResp)
@@ -167,6 +203,9 @@ type BudgetingServiceServer interface {
 	GetCategory(context.Context, *GetCategoryReq) (*GetCategoryResp, error)
 	UpdateCategory(context.Context, *UpdateCategoryReq) (*UpdateCategoryResp, error)
 	DeleteCategory(context.Context, *DeleteCategoryReq) (*DeleteCategoryResp, error)
+	SuggestCategory(context.Context, *SuggestCategoryReq) (*SuggestCategoryResp, error)
+	MoveCategory(context.Context, *MoveCategoryReq) (*MoveCategoryResp, error)
+	MergeCategories(context.Context, *MergeCategoriesReq) (*MergeCategoriesResp, error)
 	// Byudjetni boshqarish
 	CreateBudget(context.Context, *CreateBudgetReq) (*CreateBudgetResp, error)
 	GetBudgetsList(context.Context, *GetBudgetsReq) (*GetBudgetsResp, error)
@@ -195,6 +234,15 @@ func (UnimplementedBudgetingServiceServer) UpdateCategory(context.Context, *Upda
 func (UnimplementedBudgetingServiceServer) DeleteCategory(context.Context, *DeleteCategoryReq) (*DeleteCategoryResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
 }
+func (UnimplementedBudgetingServiceServer) SuggestCategory(context.Context, *SuggestCategoryReq) (*SuggestCategoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestCategory not implemented")
+}
+func (UnimplementedBudgetingServiceServer) MoveCategory(context.Context, *MoveCategoryReq) (*MoveCategoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCategory not implemented")
+}
+func (UnimplementedBudgetingServiceServer) MergeCategories(context.Context, *MergeCategoriesReq) (*MergeCategoriesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCategories not implemented")
+}
 func (UnimplementedBudgetingServiceServer) CreateBudget(context.Context, *CreateBudgetReq) (*CreateBudgetResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBudget not implemented")
 }
@@ -313,6 +361,60 @@ func _BudgetingService_DeleteCategory_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BudgetingService_SuggestCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetingServiceServer).SuggestCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetingService_SuggestCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetingServiceServer).SuggestCategory(ctx, req.(*SuggestCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetingService_MoveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetingServiceServer).MoveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetingService_MoveCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetingServiceServer).MoveCategory(ctx, req.(*MoveCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetingService_MergeCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCategoriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetingServiceServer).MergeCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BudgetingService_MergeCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetingServiceServer).MergeCategories(ctx, req.(*MergeCategoriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BudgetingService_CreateBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateBudgetReq)
 	if err := dec(in); err != nil {
@@ -430,6 +532,18 @@ var BudgetingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCategory",
 			Handler:    _BudgetingService_DeleteCategory_Handler,
 		},
+		{
+			MethodName: "SuggestCategory",
+			Handler:    _BudgetingService_SuggestCategory_Handler,
+		},
+		{
+			MethodName: "MoveCategory",
+			Handler:    _BudgetingService_MoveCategory_Handler,
+		},
+		{
+			MethodName: "MergeCategories",
+			Handler:    _BudgetingService_MergeCategories_Handler,
+		},
 		{
 			MethodName: "CreateBudget",
 			Handler:    _BudgetingService_CreateBudget_Handler,
@@ -19,16 +19,46 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	FinanceManagementService_CreateAccount_FullMethodName       = "/finance_management.FinanceManagementService/CreateAccount"
-	FinanceManagementService_UpdateAccount_FullMethodName       = "/finance_management.FinanceManagementService/UpdateAccount"
-	FinanceManagementService_GetAccount_FullMethodName          = "/finance_management.FinanceManagementService/GetAccount"
-	FinanceManagementService_GetAccountsList_FullMethodName     = "/finance_management.FinanceManagementService/GetAccountsList"
-	FinanceManagementService_DeleteAccount_FullMethodName       = "/finance_management.FinanceManagementService/DeleteAccount"
-	FinanceManagementService_CreateTransaction_FullMethodName   = "/finance_management.FinanceManagementService/CreateTransaction"
-	FinanceManagementService_UpdateTransaction_FullMethodName   = "/finance_management.FinanceManagementService/UpdateTransaction"
-	FinanceManagementService_GetTransaction_FullMethodName      = "/finance_management.FinanceManagementService/GetTransaction"
-	FinanceManagementService_GetTransactionsList_FullMethodName = "/finance_management.FinanceManagementService/GetTransactionsList"
-	FinanceManagementService_DeleteTransaction_FullMethodName   = "/finance_management.FinanceManagementService/DeleteTransaction"
+	FinanceManagementService_CreateAccount_FullMethodName                 = "/finance_management.FinanceManagementService/CreateAccount"
+	FinanceManagementService_UpdateAccount_FullMethodName                 = "/finance_management.FinanceManagementService/UpdateAccount"
+	FinanceManagementService_GetAccount_FullMethodName                    = "/finance_management.FinanceManagementService/GetAccount"
+	FinanceManagementService_GetAccountsList_FullMethodName               = "/finance_management.FinanceManagementService/GetAccountsList"
+	FinanceManagementService_DeleteAccount_FullMethodName                 = "/finance_management.FinanceManagementService/DeleteAccount"
+	FinanceManagementService_CreateTransaction_FullMethodName             = "/finance_management.FinanceManagementService/CreateTransaction"
+	FinanceManagementService_UpdateTransaction_FullMethodName             = "/finance_management.FinanceManagementService/UpdateTransaction"
+	FinanceManagementService_GetTransaction_FullMethodName                = "/finance_management.FinanceManagementService/GetTransaction"
+	FinanceManagementService_GetTransactionsList_FullMethodName           = "/finance_management.FinanceManagementService/GetTransactionsList"
+	FinanceManagementService_DeleteTransaction_FullMethodName             = "/finance_management.FinanceManagementService/DeleteTransaction"
+	FinanceManagementService_CreateTransfer_FullMethodName                = "/finance_management.FinanceManagementService/CreateTransfer"
+	FinanceManagementService_ImportStatement_FullMethodName               = "/finance_management.FinanceManagementService/ImportStatement"
+	FinanceManagementService_ListSuspectedDuplicates_FullMethodName       = "/finance_management.FinanceManagementService/ListSuspectedDuplicates"
+	FinanceManagementService_MergeDuplicates_FullMethodName               = "/finance_management.FinanceManagementService/MergeDuplicates"
+	FinanceManagementService_CreateCategoryRule_FullMethodName            = "/finance_management.FinanceManagementService/CreateCategoryRule"
+	FinanceManagementService_GetCategoryRule_FullMethodName               = "/finance_management.FinanceManagementService/GetCategoryRule"
+	FinanceManagementService_GetCategoryRulesList_FullMethodName          = "/finance_management.FinanceManagementService/GetCategoryRulesList"
+	FinanceManagementService_UpdateCategoryRule_FullMethodName            = "/finance_management.FinanceManagementService/UpdateCategoryRule"
+	FinanceManagementService_DeleteCategoryRule_FullMethodName            = "/finance_management.FinanceManagementService/DeleteCategoryRule"
+	FinanceManagementService_TestCategoryRules_FullMethodName             = "/finance_management.FinanceManagementService/TestCategoryRules"
+	FinanceManagementService_ApplyCategoryRules_FullMethodName            = "/finance_management.FinanceManagementService/ApplyCategoryRules"
+	FinanceManagementService_AddTransactionTags_FullMethodName            = "/finance_management.FinanceManagementService/AddTransactionTags"
+	FinanceManagementService_RemoveTransactionTags_FullMethodName         = "/finance_management.FinanceManagementService/RemoveTransactionTags"
+	FinanceManagementService_AddTransactionAttachment_FullMethodName      = "/finance_management.FinanceManagementService/AddTransactionAttachment"
+	FinanceManagementService_RemoveTransactionAttachment_FullMethodName   = "/finance_management.FinanceManagementService/RemoveTransactionAttachment"
+	FinanceManagementService_GetTrialBalance_FullMethodName               = "/finance_management.FinanceManagementService/GetTrialBalance"
+	FinanceManagementService_SetExchangeRates_FullMethodName              = "/finance_management.FinanceManagementService/SetExchangeRates"
+	FinanceManagementService_SetBaseCurrency_FullMethodName               = "/finance_management.FinanceManagementService/SetBaseCurrency"
+	FinanceManagementService_CreateRecurringTransaction_FullMethodName    = "/finance_management.FinanceManagementService/CreateRecurringTransaction"
+	FinanceManagementService_GetRecurringTransaction_FullMethodName       = "/finance_management.FinanceManagementService/GetRecurringTransaction"
+	FinanceManagementService_GetRecurringTransactionsList_FullMethodName  = "/finance_management.FinanceManagementService/GetRecurringTransactionsList"
+	FinanceManagementService_UpdateRecurringTransaction_FullMethodName    = "/finance_management.FinanceManagementService/UpdateRecurringTransaction"
+	FinanceManagementService_DeleteRecurringTransaction_FullMethodName    = "/finance_management.FinanceManagementService/DeleteRecurringTransaction"
+	FinanceManagementService_SkipRecurringOccurrence_FullMethodName       = "/finance_management.FinanceManagementService/SkipRecurringOccurrence"
+	FinanceManagementService_SetRecurringTransactionStatus_FullMethodName = "/finance_management.FinanceManagementService/SetRecurringTransactionStatus"
+	FinanceManagementService_InviteAccountMember_FullMethodName           = "/finance_management.FinanceManagementService/InviteAccountMember"
+	FinanceManagementService_AcceptAccountInvitation_FullMethodName       = "/finance_management.FinanceManagementService/AcceptAccountInvitation"
+	FinanceManagementService_RevokeAccountMember_FullMethodName           = "/finance_management.FinanceManagementService/RevokeAccountMember"
+	FinanceManagementService_GetAccountMembers_FullMethodName             = "/finance_management.FinanceManagementService/GetAccountMembers"
+	FinanceManagementService_GetAccountInvitations_FullMethodName         = "/finance_management.FinanceManagementService/GetAccountInvitations"
 )
 
 // FinanceManagementServiceClient is the client API for FinanceManagementService service.
@@ -47,6 +77,41 @@ type FinanceManagementServiceClient interface {
 	GetTransaction(ctx context.Context, in *GetTransactionReq, opts ...grpc.CallOption) (*GetTransactionResp, error)
 	GetTransactionsList(ctx context.Context, in *GetTransactionsListReq, opts ...grpc.CallOption) (*GetTransactionsListResp, error)
 	DeleteTransaction(ctx context.Context, in *DeleteTransactionReq, opts ...grpc.CallOption) (*DeleteTransactionResp, error)
+	CreateTransfer(ctx context.Context, in *CreateTransferReq, opts ...grpc.CallOption) (*CreateTransferResp, error)
+	ImportStatement(ctx context.Context, opts ...grpc.CallOption) (FinanceManagementService_ImportStatementClient, error)
+	ListSuspectedDuplicates(ctx context.Context, in *ListSuspectedDuplicatesReq, opts ...grpc.CallOption) (*ListSuspectedDuplicatesResp, error)
+	MergeDuplicates(ctx context.Context, in *MergeDuplicatesReq, opts ...grpc.CallOption) (*MergeDuplicatesResp, error)
+	// Avtomatik kategoriyalash qoidalari
+	CreateCategoryRule(ctx context.Context, in *CreateCategoryRuleReq, opts ...grpc.CallOption) (*CreateCategoryRuleResp, error)
+	GetCategoryRule(ctx context.Context, in *GetCategoryRuleReq, opts ...grpc.CallOption) (*GetCategoryRuleResp, error)
+	GetCategoryRulesList(ctx context.Context, in *GetCategoryRulesListReq, opts ...grpc.CallOption) (*GetCategoryRulesListResp, error)
+	UpdateCategoryRule(ctx context.Context, in *UpdateCategoryRuleReq, opts ...grpc.CallOption) (*UpdateCategoryRuleResp, error)
+	DeleteCategoryRule(ctx context.Context, in *DeleteCategoryRuleReq, opts ...grpc.CallOption) (*DeleteCategoryRuleResp, error)
+	TestCategoryRules(ctx context.Context, in *TestCategoryRulesReq, opts ...grpc.CallOption) (*TestCategoryRulesResp, error)
+	ApplyCategoryRules(ctx context.Context, in *ApplyCategoryRulesReq, opts ...grpc.CallOption) (*ApplyCategoryRulesResp, error)
+	AddTransactionTags(ctx context.Context, in *AddTransactionTagsReq, opts ...grpc.CallOption) (*AddTransactionTagsResp, error)
+	RemoveTransactionTags(ctx context.Context, in *RemoveTransactionTagsReq, opts ...grpc.CallOption) (*RemoveTransactionTagsResp, error)
+	AddTransactionAttachment(ctx context.Context, in *AddTransactionAttachmentReq, opts ...grpc.CallOption) (*AddTransactionAttachmentResp, error)
+	RemoveTransactionAttachment(ctx context.Context, in *RemoveTransactionAttachmentReq, opts ...grpc.CallOption) (*RemoveTransactionAttachmentResp, error)
+	// Ikki tomonlama yozuvlar (double-entry)
+	GetTrialBalance(ctx context.Context, in *GetTrialBalanceReq, opts ...grpc.CallOption) (*GetTrialBalanceResp, error)
+	// Valyuta kurslari
+	SetExchangeRates(ctx context.Context, in *SetExchangeRatesReq, opts ...grpc.CallOption) (*SetExchangeRatesResp, error)
+	SetBaseCurrency(ctx context.Context, in *SetBaseCurrencyReq, opts ...grpc.CallOption) (*SetBaseCurrencyResp, error)
+	// Takrorlanuvchi tranzaksiyalar
+	CreateRecurringTransaction(ctx context.Context, in *CreateRecurringTransactionReq, opts ...grpc.CallOption) (*CreateRecurringTransactionResp, error)
+	GetRecurringTransaction(ctx context.Context, in *GetRecurringTransactionReq, opts ...grpc.CallOption) (*GetRecurringTransactionResp, error)
+	GetRecurringTransactionsList(ctx context.Context, in *GetRecurringTransactionsListReq, opts ...grpc.CallOption) (*GetRecurringTransactionsListResp, error)
+	UpdateRecurringTransaction(ctx context.Context, in *UpdateRecurringTransactionReq, opts ...grpc.CallOption) (*UpdateRecurringTransactionResp, error)
+	DeleteRecurringTransaction(ctx context.Context, in *DeleteRecurringTransactionReq, opts ...grpc.CallOption) (*DeleteRecurringTransactionResp, error)
+	SkipRecurringOccurrence(ctx context.Context, in *SkipRecurringOccurrenceReq, opts ...grpc.CallOption) (*SkipRecurringOccurrenceResp, error)
+	SetRecurringTransactionStatus(ctx context.Context, in *SetRecurringTransactionStatusReq, opts ...grpc.CallOption) (*SetRecurringTransactionStatusResp, error)
+	// Umumiy hisoblar
+	InviteAccountMember(ctx context.Context, in *InviteAccountMemberReq, opts ...grpc.CallOption) (*InviteAccountMemberResp, error)
+	AcceptAccountInvitation(ctx context.Context, in *AcceptAccountInvitationReq, opts ...grpc.CallOption) (*AcceptAccountInvitationResp, error)
+	RevokeAccountMember(ctx context.Context, in *RevokeAccountMemberReq, opts ...grpc.CallOption) (*RevokeAccountMemberResp, error)
+	GetAccountMembers(ctx context.Context, in *GetAccountMembersReq, opts ...grpc.CallOption) (*GetAccountMembersResp, error)
+	GetAccountInvitations(ctx context.Context, in *GetAccountInvitationsReq, opts ...grpc.CallOption) (*GetAccountInvitationsResp, error)
 }
 
 type financeManagementServiceClient struct {
@@ -157,6 +222,331 @@ func (c *financeManagementServiceClient) DeleteTransaction(ctx context.Context,
 	return out, nil
 }
 
+func (c *financeManagementServiceClient) CreateTransfer(ctx context.Context, in *CreateTransferReq, opts ...grpc.CallOption) (*CreateTransferResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTransferResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_CreateTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) ImportStatement(ctx context.Context, opts ...grpc.CallOption) (FinanceManagementService_ImportStatementClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FinanceManagementService_ServiceDesc.Streams[0], FinanceManagementService_ImportStatement_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &financeManagementServiceImportStatementClient{ClientStream: stream}
+	return x, nil
+}
+
+type FinanceManagementService_ImportStatementClient interface {
+	Send(*ImportStatementReq) error
+	CloseAndRecv() (*ImportStatementResp, error)
+	grpc.ClientStream
+}
+
+type financeManagementServiceImportStatementClient struct {
+	grpc.ClientStream
+}
+
+func (x *financeManagementServiceImportStatementClient) Send(m *ImportStatementReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *financeManagementServiceImportStatementClient) CloseAndRecv() (*ImportStatementResp, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportStatementResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *financeManagementServiceClient) ListSuspectedDuplicates(ctx context.Context, in *ListSuspectedDuplicatesReq, opts ...grpc.CallOption) (*ListSuspectedDuplicatesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSuspectedDuplicatesResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_ListSuspectedDuplicates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) MergeDuplicates(ctx context.Context, in *MergeDuplicatesReq, opts ...grpc.CallOption) (*MergeDuplicatesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeDuplicatesResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_MergeDuplicates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) CreateCategoryRule(ctx context.Context, in *CreateCategoryRuleReq, opts ...grpc.CallOption) (*CreateCategoryRuleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCategoryRuleResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_CreateCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) GetCategoryRule(ctx context.Context, in *GetCategoryRuleReq, opts ...grpc.CallOption) (*GetCategoryRuleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryRuleResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_GetCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) GetCategoryRulesList(ctx context.Context, in *GetCategoryRulesListReq, opts ...grpc.CallOption) (*GetCategoryRulesListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryRulesListResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_GetCategoryRulesList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) UpdateCategoryRule(ctx context.Context, in *UpdateCategoryRuleReq, opts ...grpc.CallOption) (*UpdateCategoryRuleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCategoryRuleResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_UpdateCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) DeleteCategoryRule(ctx context.Context, in *DeleteCategoryRuleReq, opts ...grpc.CallOption) (*DeleteCategoryRuleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryRuleResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_DeleteCategoryRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) TestCategoryRules(ctx context.Context, in *TestCategoryRulesReq, opts ...grpc.CallOption) (*TestCategoryRulesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestCategoryRulesResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_TestCategoryRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) ApplyCategoryRules(ctx context.Context, in *ApplyCategoryRulesReq, opts ...grpc.CallOption) (*ApplyCategoryRulesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyCategoryRulesResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_ApplyCategoryRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) AddTransactionTags(ctx context.Context, in *AddTransactionTagsReq, opts ...grpc.CallOption) (*AddTransactionTagsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTransactionTagsResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_AddTransactionTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) RemoveTransactionTags(ctx context.Context, in *RemoveTransactionTagsReq, opts ...grpc.CallOption) (*RemoveTransactionTagsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveTransactionTagsResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_RemoveTransactionTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) AddTransactionAttachment(ctx context.Context, in *AddTransactionAttachmentReq, opts ...grpc.CallOption) (*AddTransactionAttachmentResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTransactionAttachmentResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_AddTransactionAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) RemoveTransactionAttachment(ctx context.Context, in *RemoveTransactionAttachmentReq, opts ...grpc.CallOption) (*RemoveTransactionAttachmentResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveTransactionAttachmentResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_RemoveTransactionAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) GetTrialBalance(ctx context.Context, in *GetTrialBalanceReq, opts ...grpc.CallOption) (*GetTrialBalanceResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrialBalanceResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_GetTrialBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) SetExchangeRates(ctx context.Context, in *SetExchangeRatesReq, opts ...grpc.CallOption) (*SetExchangeRatesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetExchangeRatesResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_SetExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) SetBaseCurrency(ctx context.Context, in *SetBaseCurrencyReq, opts ...grpc.CallOption) (*SetBaseCurrencyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBaseCurrencyResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_SetBaseCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) CreateRecurringTransaction(ctx context.Context, in *CreateRecurringTransactionReq, opts ...grpc.CallOption) (*CreateRecurringTransactionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRecurringTransactionResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_CreateRecurringTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) GetRecurringTransaction(ctx context.Context, in *GetRecurringTransactionReq, opts ...grpc.CallOption) (*GetRecurringTransactionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecurringTransactionResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_GetRecurringTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) GetRecurringTransactionsList(ctx context.Context, in *GetRecurringTransactionsListReq, opts ...grpc.CallOption) (*GetRecurringTransactionsListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecurringTransactionsListResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_GetRecurringTransactionsList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) UpdateRecurringTransaction(ctx context.Context, in *UpdateRecurringTransactionReq, opts ...grpc.CallOption) (*UpdateRecurringTransactionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRecurringTransactionResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_UpdateRecurringTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) DeleteRecurringTransaction(ctx context.Context, in *DeleteRecurringTransactionReq, opts ...grpc.CallOption) (*DeleteRecurringTransactionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRecurringTransactionResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_DeleteRecurringTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) SkipRecurringOccurrence(ctx context.Context, in *SkipRecurringOccurrenceReq, opts ...grpc.CallOption) (*SkipRecurringOccurrenceResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkipRecurringOccurrenceResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_SkipRecurringOccurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) SetRecurringTransactionStatus(ctx context.Context, in *SetRecurringTransactionStatusReq, opts ...grpc.CallOption) (*SetRecurringTransactionStatusResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRecurringTransactionStatusResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_SetRecurringTransactionStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) InviteAccountMember(ctx context.Context, in *InviteAccountMemberReq, opts ...grpc.CallOption) (*InviteAccountMemberResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteAccountMemberResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_InviteAccountMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) AcceptAccountInvitation(ctx context.Context, in *AcceptAccountInvitationReq, opts ...grpc.CallOption) (*AcceptAccountInvitationResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptAccountInvitationResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_AcceptAccountInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) RevokeAccountMember(ctx context.Context, in *RevokeAccountMemberReq, opts ...grpc.CallOption) (*RevokeAccountMemberResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAccountMemberResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_RevokeAccountMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) GetAccountMembers(ctx context.Context, in *GetAccountMembersReq, opts ...grpc.CallOption) (*GetAccountMembersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountMembersResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_GetAccountMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeManagementServiceClient) GetAccountInvitations(ctx context.Context, in *GetAccountInvitationsReq, opts ...grpc.CallOption) (*GetAccountInvitationsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountInvitationsResp)
+	err := c.cc.Invoke(ctx, FinanceManagementService_GetAccountInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FinanceManagementServiceServer is the server API for FinanceManagementService service.
 // All implementations must embed UnimplementedFinanceManagementServiceServer
 // for forward compatibility
@@ -173,6 +563,41 @@ type FinanceManagementServiceServer interface {
 	GetTransaction(context.Context, *GetTransactionReq) (*GetTransactionResp, error)
 	GetTransactionsList(context.Context, *GetTransactionsListReq) (*GetTransactionsListResp, error)
 	DeleteTransaction(context.Context, *DeleteTransactionReq) (*DeleteTransactionResp, error)
+	CreateTransfer(context.Context, *CreateTransferReq) (*CreateTransferResp, error)
+	ImportStatement(FinanceManagementService_ImportStatementServer) error
+	ListSuspectedDuplicates(context.Context, *ListSuspectedDuplicatesReq) (*ListSuspectedDuplicatesResp, error)
+	MergeDuplicates(context.Context, *MergeDuplicatesReq) (*MergeDuplicatesResp, error)
+	// Avtomatik kategoriyalash qoidalari
+	CreateCategoryRule(context.Context, *CreateCategoryRuleReq) (*CreateCategoryRuleResp, error)
+	GetCategoryRule(context.Context, *GetCategoryRuleReq) (*GetCategoryRuleResp, error)
+	GetCategoryRulesList(context.Context, *GetCategoryRulesListReq) (*GetCategoryRulesListResp, error)
+	UpdateCategoryRule(context.Context, *UpdateCategoryRuleReq) (*UpdateCategoryRuleResp, error)
+	DeleteCategoryRule(context.Context, *DeleteCategoryRuleReq) (*DeleteCategoryRuleResp, error)
+	TestCategoryRules(context.Context, *TestCategoryRulesReq) (*TestCategoryRulesResp, error)
+	ApplyCategoryRules(context.Context, *ApplyCategoryRulesReq) (*ApplyCategoryRulesResp, error)
+	AddTransactionTags(context.Context, *AddTransactionTagsReq) (*AddTransactionTagsResp, error)
+	RemoveTransactionTags(context.Context, *RemoveTransactionTagsReq) (*RemoveTransactionTagsResp, error)
+	AddTransactionAttachment(context.Context, *AddTransactionAttachmentReq) (*AddTransactionAttachmentResp, error)
+	RemoveTransactionAttachment(context.Context, *RemoveTransactionAttachmentReq) (*RemoveTransactionAttachmentResp, error)
+	// Ikki tomonlama yozuvlar (double-entry)
+	GetTrialBalance(context.Context, *GetTrialBalanceReq) (*GetTrialBalanceResp, error)
+	// Valyuta kurslari
+	SetExchangeRates(context.Context, *SetExchangeRatesReq) (*SetExchangeRatesResp, error)
+	SetBaseCurrency(context.Context, *SetBaseCurrencyReq) (*SetBaseCurrencyResp, error)
+	// Takrorlanuvchi tranzaksiyalar
+	CreateRecurringTransaction(context.Context, *CreateRecurringTransactionReq) (*CreateRecurringTransactionResp, error)
+	GetRecurringTransaction(context.Context, *GetRecurringTransactionReq) (*GetRecurringTransactionResp, error)
+	GetRecurringTransactionsList(context.Context, *GetRecurringTransactionsListReq) (*GetRecurringTransactionsListResp, error)
+	UpdateRecurringTransaction(context.Context, *UpdateRecurringTransactionReq) (*UpdateRecurringTransactionResp, error)
+	DeleteRecurringTransaction(context.Context, *DeleteRecurringTransactionReq) (*DeleteRecurringTransactionResp, error)
+	SkipRecurringOccurrence(context.Context, *SkipRecurringOccurrenceReq) (*SkipRecurringOccurrenceResp, error)
+	SetRecurringTransactionStatus(context.Context, *SetRecurringTransactionStatusReq) (*SetRecurringTransactionStatusResp, error)
+	// Umumiy hisoblar
+	InviteAccountMember(context.Context, *InviteAccountMemberReq) (*InviteAccountMemberResp, error)
+	AcceptAccountInvitation(context.Context, *AcceptAccountInvitationReq) (*AcceptAccountInvitationResp, error)
+	RevokeAccountMember(context.Context, *RevokeAccountMemberReq) (*RevokeAccountMemberResp, error)
+	GetAccountMembers(context.Context, *GetAccountMembersReq) (*GetAccountMembersResp, error)
+	GetAccountInvitations(context.Context, *GetAccountInvitationsReq) (*GetAccountInvitationsResp, error)
 	mustEmbedUnimplementedFinanceManagementServiceServer()
 }
 
@@ -210,6 +635,96 @@ func (UnimplementedFinanceManagementServiceServer) GetTransactionsList(context.C
 func (UnimplementedFinanceManagementServiceServer) DeleteTransaction(context.Context, *DeleteTransactionReq) (*DeleteTransactionResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransaction not implemented")
 }
+func (UnimplementedFinanceManagementServiceServer) CreateTransfer(context.Context, *CreateTransferReq) (*CreateTransferResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransfer not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) ImportStatement(FinanceManagementService_ImportStatementServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportStatement not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) ListSuspectedDuplicates(context.Context, *ListSuspectedDuplicatesReq) (*ListSuspectedDuplicatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuspectedDuplicates not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) MergeDuplicates(context.Context, *MergeDuplicatesReq) (*MergeDuplicatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeDuplicates not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) CreateCategoryRule(context.Context, *CreateCategoryRuleReq) (*CreateCategoryRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategoryRule not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) GetCategoryRule(context.Context, *GetCategoryRuleReq) (*GetCategoryRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryRule not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) GetCategoryRulesList(context.Context, *GetCategoryRulesListReq) (*GetCategoryRulesListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryRulesList not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) UpdateCategoryRule(context.Context, *UpdateCategoryRuleReq) (*UpdateCategoryRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategoryRule not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) DeleteCategoryRule(context.Context, *DeleteCategoryRuleReq) (*DeleteCategoryRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryRule not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) TestCategoryRules(context.Context, *TestCategoryRulesReq) (*TestCategoryRulesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestCategoryRules not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) ApplyCategoryRules(context.Context, *ApplyCategoryRulesReq) (*ApplyCategoryRulesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyCategoryRules not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) AddTransactionTags(context.Context, *AddTransactionTagsReq) (*AddTransactionTagsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTransactionTags not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) RemoveTransactionTags(context.Context, *RemoveTransactionTagsReq) (*RemoveTransactionTagsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTransactionTags not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) AddTransactionAttachment(context.Context, *AddTransactionAttachmentReq) (*AddTransactionAttachmentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTransactionAttachment not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) RemoveTransactionAttachment(context.Context, *RemoveTransactionAttachmentReq) (*RemoveTransactionAttachmentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTransactionAttachment not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) GetTrialBalance(context.Context, *GetTrialBalanceReq) (*GetTrialBalanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrialBalance not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) SetExchangeRates(context.Context, *SetExchangeRatesReq) (*SetExchangeRatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeRates not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) SetBaseCurrency(context.Context, *SetBaseCurrencyReq) (*SetBaseCurrencyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBaseCurrency not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) CreateRecurringTransaction(context.Context, *CreateRecurringTransactionReq) (*CreateRecurringTransactionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringTransaction not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) GetRecurringTransaction(context.Context, *GetRecurringTransactionReq) (*GetRecurringTransactionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecurringTransaction not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) GetRecurringTransactionsList(context.Context, *GetRecurringTransactionsListReq) (*GetRecurringTransactionsListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecurringTransactionsList not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) UpdateRecurringTransaction(context.Context, *UpdateRecurringTransactionReq) (*UpdateRecurringTransactionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecurringTransaction not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) DeleteRecurringTransaction(context.Context, *DeleteRecurringTransactionReq) (*DeleteRecurringTransactionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecurringTransaction not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) SkipRecurringOccurrence(context.Context, *SkipRecurringOccurrenceReq) (*SkipRecurringOccurrenceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipRecurringOccurrence not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) SetRecurringTransactionStatus(context.Context, *SetRecurringTransactionStatusReq) (*SetRecurringTransactionStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRecurringTransactionStatus not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) InviteAccountMember(context.Context, *InviteAccountMemberReq) (*InviteAccountMemberResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteAccountMember not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) AcceptAccountInvitation(context.Context, *AcceptAccountInvitationReq) (*AcceptAccountInvitationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAccountInvitation not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) RevokeAccountMember(context.Context, *RevokeAccountMemberReq) (*RevokeAccountMemberResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccountMember not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) GetAccountMembers(context.Context, *GetAccountMembersReq) (*GetAccountMembersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountMembers not implemented")
+}
+func (UnimplementedFinanceManagementServiceServer) GetAccountInvitations(context.Context, *GetAccountInvitationsReq) (*GetAccountInvitationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInvitations not implemented")
+}
 func (UnimplementedFinanceManagementServiceServer) mustEmbedUnimplementedFinanceManagementServiceServer() {
 }
 
@@ -404,11 +919,559 @@ func _FinanceManagementService_DeleteTransaction_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
-// FinanceManagementService_ServiceDesc is the grpc.ServiceDesc for FinanceManagementService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var FinanceManagementService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "finance_management.FinanceManagementService",
+func _FinanceManagementService_CreateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransferReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).CreateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_CreateTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).CreateTransfer(ctx, req.(*CreateTransferReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_ImportStatement_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FinanceManagementServiceServer).ImportStatement(&financeManagementServiceImportStatementServer{ServerStream: stream})
+}
+
+type FinanceManagementService_ImportStatementServer interface {
+	SendAndClose(*ImportStatementResp) error
+	Recv() (*ImportStatementReq, error)
+	grpc.ServerStream
+}
+
+type financeManagementServiceImportStatementServer struct {
+	grpc.ServerStream
+}
+
+func (x *financeManagementServiceImportStatementServer) SendAndClose(m *ImportStatementResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *financeManagementServiceImportStatementServer) Recv() (*ImportStatementReq, error) {
+	m := new(ImportStatementReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _FinanceManagementService_ListSuspectedDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuspectedDuplicatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).ListSuspectedDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_ListSuspectedDuplicates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).ListSuspectedDuplicates(ctx, req.(*ListSuspectedDuplicatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_MergeDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeDuplicatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).MergeDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_MergeDuplicates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).MergeDuplicates(ctx, req.(*MergeDuplicatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_CreateCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).CreateCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_CreateCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).CreateCategoryRule(ctx, req.(*CreateCategoryRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_GetCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).GetCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_GetCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).GetCategoryRule(ctx, req.(*GetCategoryRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_GetCategoryRulesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRulesListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).GetCategoryRulesList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_GetCategoryRulesList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).GetCategoryRulesList(ctx, req.(*GetCategoryRulesListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_UpdateCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).UpdateCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_UpdateCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).UpdateCategoryRule(ctx, req.(*UpdateCategoryRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_DeleteCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).DeleteCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_DeleteCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).DeleteCategoryRule(ctx, req.(*DeleteCategoryRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_TestCategoryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestCategoryRulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).TestCategoryRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_TestCategoryRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).TestCategoryRules(ctx, req.(*TestCategoryRulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_ApplyCategoryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyCategoryRulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).ApplyCategoryRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_ApplyCategoryRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).ApplyCategoryRules(ctx, req.(*ApplyCategoryRulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_AddTransactionTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTransactionTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).AddTransactionTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_AddTransactionTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).AddTransactionTags(ctx, req.(*AddTransactionTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_RemoveTransactionTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTransactionTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).RemoveTransactionTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_RemoveTransactionTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).RemoveTransactionTags(ctx, req.(*RemoveTransactionTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_AddTransactionAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTransactionAttachmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).AddTransactionAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_AddTransactionAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).AddTransactionAttachment(ctx, req.(*AddTransactionAttachmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_RemoveTransactionAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTransactionAttachmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).RemoveTransactionAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_RemoveTransactionAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).RemoveTransactionAttachment(ctx, req.(*RemoveTransactionAttachmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_GetTrialBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrialBalanceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).GetTrialBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_GetTrialBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).GetTrialBalance(ctx, req.(*GetTrialBalanceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_SetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeRatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).SetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_SetExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).SetExchangeRates(ctx, req.(*SetExchangeRatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_SetBaseCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBaseCurrencyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).SetBaseCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_SetBaseCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).SetBaseCurrency(ctx, req.(*SetBaseCurrencyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_CreateRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringTransactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).CreateRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_CreateRecurringTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).CreateRecurringTransaction(ctx, req.(*CreateRecurringTransactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_GetRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecurringTransactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).GetRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_GetRecurringTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).GetRecurringTransaction(ctx, req.(*GetRecurringTransactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_GetRecurringTransactionsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecurringTransactionsListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).GetRecurringTransactionsList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_GetRecurringTransactionsList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).GetRecurringTransactionsList(ctx, req.(*GetRecurringTransactionsListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_UpdateRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecurringTransactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).UpdateRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_UpdateRecurringTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).UpdateRecurringTransaction(ctx, req.(*UpdateRecurringTransactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_DeleteRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecurringTransactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).DeleteRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_DeleteRecurringTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).DeleteRecurringTransaction(ctx, req.(*DeleteRecurringTransactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_SkipRecurringOccurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipRecurringOccurrenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).SkipRecurringOccurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_SkipRecurringOccurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).SkipRecurringOccurrence(ctx, req.(*SkipRecurringOccurrenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_SetRecurringTransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRecurringTransactionStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).SetRecurringTransactionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_SetRecurringTransactionStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).SetRecurringTransactionStatus(ctx, req.(*SetRecurringTransactionStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_InviteAccountMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteAccountMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).InviteAccountMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_InviteAccountMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).InviteAccountMember(ctx, req.(*InviteAccountMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_AcceptAccountInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptAccountInvitationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).AcceptAccountInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_AcceptAccountInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).AcceptAccountInvitation(ctx, req.(*AcceptAccountInvitationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_RevokeAccountMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccountMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).RevokeAccountMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_RevokeAccountMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).RevokeAccountMember(ctx, req.(*RevokeAccountMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_GetAccountMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).GetAccountMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_GetAccountMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).GetAccountMembers(ctx, req.(*GetAccountMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceManagementService_GetAccountInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInvitationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceManagementServiceServer).GetAccountInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceManagementService_GetAccountInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceManagementServiceServer).GetAccountInvitations(ctx, req.(*GetAccountInvitationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FinanceManagementService_ServiceDesc is the grpc.ServiceDesc for FinanceManagementService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FinanceManagementService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance_management.FinanceManagementService",
 	HandlerType: (*FinanceManagementServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -451,7 +1514,129 @@ var FinanceManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTransaction",
 			Handler:    _FinanceManagementService_DeleteTransaction_Handler,
 		},
+		{
+			MethodName: "CreateTransfer",
+			Handler:    _FinanceManagementService_CreateTransfer_Handler,
+		},
+		{
+			MethodName: "ListSuspectedDuplicates",
+			Handler:    _FinanceManagementService_ListSuspectedDuplicates_Handler,
+		},
+		{
+			MethodName: "MergeDuplicates",
+			Handler:    _FinanceManagementService_MergeDuplicates_Handler,
+		},
+		{
+			MethodName: "CreateCategoryRule",
+			Handler:    _FinanceManagementService_CreateCategoryRule_Handler,
+		},
+		{
+			MethodName: "GetCategoryRule",
+			Handler:    _FinanceManagementService_GetCategoryRule_Handler,
+		},
+		{
+			MethodName: "GetCategoryRulesList",
+			Handler:    _FinanceManagementService_GetCategoryRulesList_Handler,
+		},
+		{
+			MethodName: "UpdateCategoryRule",
+			Handler:    _FinanceManagementService_UpdateCategoryRule_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryRule",
+			Handler:    _FinanceManagementService_DeleteCategoryRule_Handler,
+		},
+		{
+			MethodName: "TestCategoryRules",
+			Handler:    _FinanceManagementService_TestCategoryRules_Handler,
+		},
+		{
+			MethodName: "ApplyCategoryRules",
+			Handler:    _FinanceManagementService_ApplyCategoryRules_Handler,
+		},
+		{
+			MethodName: "AddTransactionTags",
+			Handler:    _FinanceManagementService_AddTransactionTags_Handler,
+		},
+		{
+			MethodName: "RemoveTransactionTags",
+			Handler:    _FinanceManagementService_RemoveTransactionTags_Handler,
+		},
+		{
+			MethodName: "AddTransactionAttachment",
+			Handler:    _FinanceManagementService_AddTransactionAttachment_Handler,
+		},
+		{
+			MethodName: "RemoveTransactionAttachment",
+			Handler:    _FinanceManagementService_RemoveTransactionAttachment_Handler,
+		},
+		{
+			MethodName: "GetTrialBalance",
+			Handler:    _FinanceManagementService_GetTrialBalance_Handler,
+		},
+		{
+			MethodName: "SetExchangeRates",
+			Handler:    _FinanceManagementService_SetExchangeRates_Handler,
+		},
+		{
+			MethodName: "SetBaseCurrency",
+			Handler:    _FinanceManagementService_SetBaseCurrency_Handler,
+		},
+		{
+			MethodName: "CreateRecurringTransaction",
+			Handler:    _FinanceManagementService_CreateRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "GetRecurringTransaction",
+			Handler:    _FinanceManagementService_GetRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "GetRecurringTransactionsList",
+			Handler:    _FinanceManagementService_GetRecurringTransactionsList_Handler,
+		},
+		{
+			MethodName: "UpdateRecurringTransaction",
+			Handler:    _FinanceManagementService_UpdateRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "DeleteRecurringTransaction",
+			Handler:    _FinanceManagementService_DeleteRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "SkipRecurringOccurrence",
+			Handler:    _FinanceManagementService_SkipRecurringOccurrence_Handler,
+		},
+		{
+			MethodName: "SetRecurringTransactionStatus",
+			Handler:    _FinanceManagementService_SetRecurringTransactionStatus_Handler,
+		},
+		{
+			MethodName: "InviteAccountMember",
+			Handler:    _FinanceManagementService_InviteAccountMember_Handler,
+		},
+		{
+			MethodName: "AcceptAccountInvitation",
+			Handler:    _FinanceManagementService_AcceptAccountInvitation_Handler,
+		},
+		{
+			MethodName: "RevokeAccountMember",
+			Handler:    _FinanceManagementService_RevokeAccountMember_Handler,
+		},
+		{
+			MethodName: "GetAccountMembers",
+			Handler:    _FinanceManagementService_GetAccountMembers_Handler,
+		},
+		{
+			MethodName: "GetAccountInvitations",
+			Handler:    _FinanceManagementService_GetAccountInvitations_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportStatement",
+			Handler:       _FinanceManagementService_ImportStatement_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "budgeting_service/finance_management.proto",
 }
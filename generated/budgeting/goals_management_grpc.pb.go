@@ -19,11 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	GoalsManagemenService_CreateGoal_FullMethodName = "/goals_management.GoalsManagemenService/CreateGoal"
-	GoalsManagemenService_GetGoals_FullMethodName   = "/goals_management.GoalsManagemenService/GetGoals"
-	GoalsManagemenService_GetGoal_FullMethodName    = "/goals_management.GoalsManagemenService/GetGoal"
-	GoalsManagemenService_UpdateGoal_FullMethodName = "/goals_management.GoalsManagemenService/UpdateGoal"
-	GoalsManagemenService_DeleteGoal_FullMethodName = "/goals_management.GoalsManagemenService/DeleteGoal"
+	GoalsManagemenService_CreateGoal_FullMethodName           = "/goals_management.GoalsManagemenService/CreateGoal"
+	GoalsManagemenService_GetGoals_FullMethodName             = "/goals_management.GoalsManagemenService/GetGoals"
+	GoalsManagemenService_GetGoal_FullMethodName              = "/goals_management.GoalsManagemenService/GetGoal"
+	GoalsManagemenService_UpdateGoal_FullMethodName           = "/goals_management.GoalsManagemenService/UpdateGoal"
+	GoalsManagemenService_DeleteGoal_FullMethodName           = "/goals_management.GoalsManagemenService/DeleteGoal"
+	GoalsManagemenService_ContributeToGoal_FullMethodName     = "/goals_management.GoalsManagemenService/ContributeToGoal"
+	GoalsManagemenService_WithdrawFromGoal_FullMethodName     = "/goals_management.GoalsManagemenService/WithdrawFromGoal"
+	GoalsManagemenService_GetGoalContributions_FullMethodName = "/goals_management.GoalsManagemenService/GetGoalContributions"
 )
 
 // GoalsManagemenServiceClient is the client API for GoalsManagemenService service.
@@ -36,6 +39,10 @@ type GoalsManagemenServiceClient interface {
 	GetGoal(ctx context.Context, in *GetGoalReq, opts ...grpc.CallOption) (*GetGoalResp, error)
 	UpdateGoal(ctx context.Context, in *UpdateGoalReq, opts ...grpc.CallOption) (*UpdateGoalResp, error)
 	DeleteGoal(ctx context.Context, in *DeleteGoalReq, opts ...grpc.CallOption) (*DeleteGoalResp, error)
+	// Maqsadga jamg'arish
+	ContributeToGoal(ctx context.Context, in *ContributeToGoalReq, opts ...grpc.CallOption) (*ContributeToGoalResp, error)
+	WithdrawFromGoal(ctx context.Context, in *WithdrawFromGoalReq, opts ...grpc.CallOption) (*WithdrawFromGoalResp, error)
+	GetGoalContributions(ctx context.Context, in *GetGoalContributionsReq, opts ...grpc.CallOption) (*GetGoalContributionsResp, error)
 }
 
 type goalsManagemenServiceClient struct {
@@ -96,6 +103,36 @@ func (c *goalsManagemenServiceClient) DeleteGoal(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *goalsManagemenServiceClient) ContributeToGoal(ctx context.Context, in *ContributeToGoalReq, opts ...grpc.CallOption) (*ContributeToGoalResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContributeToGoalResp)
+	err := c.cc.Invoke(ctx, GoalsManagemenService_ContributeToGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goalsManagemenServiceClient) WithdrawFromGoal(ctx context.Context, in *WithdrawFromGoalReq, opts ...grpc.CallOption) (*WithdrawFromGoalResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawFromGoalResp)
+	err := c.cc.Invoke(ctx, GoalsManagemenService_WithdrawFromGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goalsManagemenServiceClient) GetGoalContributions(ctx context.Context, in *GetGoalContributionsReq, opts ...grpc.CallOption) (*GetGoalContributionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGoalContributionsResp)
+	err := c.cc.Invoke(ctx, GoalsManagemenService_GetGoalContributions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoalsManagemenServiceServer is the server API for GoalsManagemenService service.
 // All implementations must embed UnimplementedGoalsManagemenServiceServer
 // for forward compatibility
@@ -106,6 +143,10 @@ type GoalsManagemenServiceServer interface {
 	GetGoal(context.Context, *GetGoalReq) (*GetGoalResp, error)
 	UpdateGoal(context.Context, *UpdateGoalReq) (*UpdateGoalResp, error)
 	DeleteGoal(context.Context, *DeleteGoalReq) (*DeleteGoalResp, error)
+	// Maqsadga jamg'arish
+	ContributeToGoal(context.Context, *ContributeToGoalReq) (*ContributeToGoalResp, error)
+	WithdrawFromGoal(context.Context, *WithdrawFromGoalReq) (*WithdrawFromGoalResp, error)
+	GetGoalContributions(context.Context, *GetGoalContributionsReq) (*GetGoalContributionsResp, error)
 	mustEmbedUnimplementedGoalsManagemenServiceServer()
 }
 
@@ -128,6 +169,15 @@ func (UnimplementedGoalsManagemenServiceServer) UpdateGoal(context.Context, *Upd
 func (UnimplementedGoalsManagemenServiceServer) DeleteGoal(context.Context, *DeleteGoalReq) (*DeleteGoalResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGoal not implemented")
 }
+func (UnimplementedGoalsManagemenServiceServer) ContributeToGoal(context.Context, *ContributeToGoalReq) (*ContributeToGoalResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContributeToGoal not implemented")
+}
+func (UnimplementedGoalsManagemenServiceServer) WithdrawFromGoal(context.Context, *WithdrawFromGoalReq) (*WithdrawFromGoalResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromGoal not implemented")
+}
+func (UnimplementedGoalsManagemenServiceServer) GetGoalContributions(context.Context, *GetGoalContributionsReq) (*GetGoalContributionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoalContributions not implemented")
+}
 func (UnimplementedGoalsManagemenServiceServer) mustEmbedUnimplementedGoalsManagemenServiceServer() {}
 
 // UnsafeGoalsManagemenServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -231,6 +281,60 @@ func _GoalsManagemenService_DeleteGoal_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoalsManagemenService_ContributeToGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContributeToGoalReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoalsManagemenServiceServer).ContributeToGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoalsManagemenService_ContributeToGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoalsManagemenServiceServer).ContributeToGoal(ctx, req.(*ContributeToGoalReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoalsManagemenService_WithdrawFromGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawFromGoalReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoalsManagemenServiceServer).WithdrawFromGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoalsManagemenService_WithdrawFromGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoalsManagemenServiceServer).WithdrawFromGoal(ctx, req.(*WithdrawFromGoalReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoalsManagemenService_GetGoalContributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGoalContributionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoalsManagemenServiceServer).GetGoalContributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoalsManagemenService_GetGoalContributions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoalsManagemenServiceServer).GetGoalContributions(ctx, req.(*GetGoalContributionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GoalsManagemenService_ServiceDesc is the grpc.ServiceDesc for GoalsManagemenService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -258,6 +362,18 @@ var GoalsManagemenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteGoal",
 			Handler:    _GoalsManagemenService_DeleteGoal_Handler,
 		},
+		{
+			MethodName: "ContributeToGoal",
+			Handler:    _GoalsManagemenService_ContributeToGoal_Handler,
+		},
+		{
+			MethodName: "WithdrawFromGoal",
+			Handler:    _GoalsManagemenService_WithdrawFromGoal_Handler,
+		},
+		{
+			MethodName: "GetGoalContributions",
+			Handler:    _GoalsManagemenService_GetGoalContributions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "budgeting_service/goals_management.proto",
@@ -89,9 +89,10 @@ type GetSependingResp struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalAmount float64 `protobuf:"fixed64,1,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	Yearly      bool    `protobuf:"varint,2,opt,name=yearly,proto3" json:"yearly,omitempty"`
-	Monthly     bool    `protobuf:"varint,3,opt,name=monthly,proto3" json:"monthly,omitempty"`
+	TotalAmount  int64  `protobuf:"varint,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Yearly       bool   `protobuf:"varint,2,opt,name=yearly,proto3" json:"yearly,omitempty"`
+	Monthly      bool   `protobuf:"varint,3,opt,name=monthly,proto3" json:"monthly,omitempty"`
+	BaseCurrency string `protobuf:"bytes,4,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
 }
 
 func (x *GetSependingResp) Reset() {
@@ -126,7 +127,7 @@ func (*GetSependingResp) Descriptor() ([]byte, []int) {
 	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GetSependingResp) GetTotalAmount() float64 {
+func (x *GetSependingResp) GetTotalAmount() int64 {
 	if x != nil {
 		return x.TotalAmount
 	}
@@ -147,6 +148,13 @@ func (x *GetSependingResp) GetMonthly() bool {
 	return false
 }
 
+func (x *GetSependingResp) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
 type GetIncomeReportReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -215,9 +223,10 @@ type GetIncomeReportResp struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalAmount float64 `protobuf:"fixed64,1,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	Yearly      bool    `protobuf:"varint,2,opt,name=yearly,proto3" json:"yearly,omitempty"`
-	Monthly     bool    `protobuf:"varint,3,opt,name=monthly,proto3" json:"monthly,omitempty"`
+	TotalAmount  int64  `protobuf:"varint,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Yearly       bool   `protobuf:"varint,2,opt,name=yearly,proto3" json:"yearly,omitempty"`
+	Monthly      bool   `protobuf:"varint,3,opt,name=monthly,proto3" json:"monthly,omitempty"`
+	BaseCurrency string `protobuf:"bytes,4,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
 }
 
 func (x *GetIncomeReportResp) Reset() {
@@ -252,7 +261,7 @@ func (*GetIncomeReportResp) Descriptor() ([]byte, []int) {
 	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetIncomeReportResp) GetTotalAmount() float64 {
+func (x *GetIncomeReportResp) GetTotalAmount() int64 {
 	if x != nil {
 		return x.TotalAmount
 	}
@@ -273,19 +282,28 @@ func (x *GetIncomeReportResp) GetMonthly() bool {
 	return false
 }
 
-// GET budget-performance
-type GetBudgetPerformanceReq struct {
+func (x *GetIncomeReportResp) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+// Teglar bo'yicha xarajatlar
+type GetSpendingByTagReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Year   int32  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
-	Month  int32  `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Bo'sh bo'lsa barcha teglar
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (x *GetBudgetPerformanceReq) Reset() {
-	*x = GetBudgetPerformanceReq{}
+func (x *GetSpendingByTagReq) Reset() {
+	*x = GetSpendingByTagReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -293,13 +311,13 @@ func (x *GetBudgetPerformanceReq) Reset() {
 	}
 }
 
-func (x *GetBudgetPerformanceReq) String() string {
+func (x *GetSpendingByTagReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBudgetPerformanceReq) ProtoMessage() {}
+func (*GetSpendingByTagReq) ProtoMessage() {}
 
-func (x *GetBudgetPerformanceReq) ProtoReflect() protoreflect.Message {
+func (x *GetSpendingByTagReq) ProtoReflect() protoreflect.Message {
 	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -311,45 +329,50 @@ func (x *GetBudgetPerformanceReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBudgetPerformanceReq.ProtoReflect.Descriptor instead.
-func (*GetBudgetPerformanceReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSpendingByTagReq.ProtoReflect.Descriptor instead.
+func (*GetSpendingByTagReq) Descriptor() ([]byte, []int) {
 	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetBudgetPerformanceReq) GetUserId() string {
+func (x *GetSpendingByTagReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetBudgetPerformanceReq) GetYear() int32 {
+func (x *GetSpendingByTagReq) GetStartDate() string {
 	if x != nil {
-		return x.Year
+		return x.StartDate
 	}
-	return 0
+	return ""
 }
 
-func (x *GetBudgetPerformanceReq) GetMonth() int32 {
+func (x *GetSpendingByTagReq) GetEndDate() string {
 	if x != nil {
-		return x.Month
+		return x.EndDate
 	}
-	return 0
+	return ""
 }
 
-type GetBudgetPerformanceResp struct {
+func (x *GetSpendingByTagReq) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type GetSpendingByTagResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId                string               `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Year                  int32                `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
-	Month                 int32                `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
-	BudgetPerformanceList []*BudgetPerformance `protobuf:"bytes,4,rep,name=budget_performance_list,json=budgetPerformanceList,proto3" json:"budget_performance_list,omitempty"`
+	Tags         []*TagSpending `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	BaseCurrency string         `protobuf:"bytes,2,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
 }
 
-func (x *GetBudgetPerformanceResp) Reset() {
-	*x = GetBudgetPerformanceResp{}
+func (x *GetSpendingByTagResp) Reset() {
+	*x = GetSpendingByTagResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -357,13 +380,13 @@ func (x *GetBudgetPerformanceResp) Reset() {
 	}
 }
 
-func (x *GetBudgetPerformanceResp) String() string {
+func (x *GetSpendingByTagResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBudgetPerformanceResp) ProtoMessage() {}
+func (*GetSpendingByTagResp) ProtoMessage() {}
 
-func (x *GetBudgetPerformanceResp) ProtoReflect() protoreflect.Message {
+func (x *GetSpendingByTagResp) ProtoReflect() protoreflect.Message {
 	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -375,52 +398,37 @@ func (x *GetBudgetPerformanceResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBudgetPerformanceResp.ProtoReflect.Descriptor instead.
-func (*GetBudgetPerformanceResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSpendingByTagResp.ProtoReflect.Descriptor instead.
+func (*GetSpendingByTagResp) Descriptor() ([]byte, []int) {
 	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetBudgetPerformanceResp) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *GetBudgetPerformanceResp) GetYear() int32 {
-	if x != nil {
-		return x.Year
-	}
-	return 0
-}
-
-func (x *GetBudgetPerformanceResp) GetMonth() int32 {
+func (x *GetSpendingByTagResp) GetTags() []*TagSpending {
 	if x != nil {
-		return x.Month
+		return x.Tags
 	}
-	return 0
+	return nil
 }
 
-func (x *GetBudgetPerformanceResp) GetBudgetPerformanceList() []*BudgetPerformance {
+func (x *GetSpendingByTagResp) GetBaseCurrency() string {
 	if x != nil {
-		return x.BudgetPerformanceList
+		return x.BaseCurrency
 	}
-	return nil
+	return ""
 }
 
-type BudgetPerformance struct {
+type TagSpending struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CategoryId string  `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Target     float64 `protobuf:"fixed64,2,opt,name=target,proto3" json:"target,omitempty"`
-	Actual     float64 `protobuf:"fixed64,3,opt,name=actual,proto3" json:"actual,omitempty"`
-	Progress   float64 `protobuf:"fixed64,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	Tag               string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Amount            int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	TransactionsCount int64  `protobuf:"varint,3,opt,name=transactions_count,json=transactionsCount,proto3" json:"transactions_count,omitempty"`
 }
 
-func (x *BudgetPerformance) Reset() {
-	*x = BudgetPerformance{}
+func (x *TagSpending) Reset() {
+	*x = TagSpending{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -428,13 +436,13 @@ func (x *BudgetPerformance) Reset() {
 	}
 }
 
-func (x *BudgetPerformance) String() string {
+func (x *TagSpending) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BudgetPerformance) ProtoMessage() {}
+func (*TagSpending) ProtoMessage() {}
 
-func (x *BudgetPerformance) ProtoReflect() protoreflect.Message {
+func (x *TagSpending) ProtoReflect() protoreflect.Message {
 	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -446,50 +454,45 @@ func (x *BudgetPerformance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BudgetPerformance.ProtoReflect.Descriptor instead.
-func (*BudgetPerformance) Descriptor() ([]byte, []int) {
+// Deprecated: Use TagSpending.ProtoReflect.Descriptor instead.
+func (*TagSpending) Descriptor() ([]byte, []int) {
 	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *BudgetPerformance) GetCategoryId() string {
+func (x *TagSpending) GetTag() string {
 	if x != nil {
-		return x.CategoryId
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *BudgetPerformance) GetTarget() float64 {
-	if x != nil {
-		return x.Target
-	}
-	return 0
-}
-
-func (x *BudgetPerformance) GetActual() float64 {
+func (x *TagSpending) GetAmount() int64 {
 	if x != nil {
-		return x.Actual
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *BudgetPerformance) GetProgress() float64 {
+func (x *TagSpending) GetTransactionsCount() int64 {
 	if x != nil {
-		return x.Progress
+		return x.TransactionsCount
 	}
 	return 0
 }
 
-// GET goal-progress
-type GetGoalProgressReq struct {
+// Kategoriyalar bo'yicha xarajatlar: bolalar xarajati ota kategoriyaga ham qo'shiladi
+type GetSpendingByCategoryReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 }
 
-func (x *GetGoalProgressReq) Reset() {
-	*x = GetGoalProgressReq{}
+func (x *GetSpendingByCategoryReq) Reset() {
+	*x = GetSpendingByCategoryReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -497,13 +500,13 @@ func (x *GetGoalProgressReq) Reset() {
 	}
 }
 
-func (x *GetGoalProgressReq) String() string {
+func (x *GetSpendingByCategoryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGoalProgressReq) ProtoMessage() {}
+func (*GetSpendingByCategoryReq) ProtoMessage() {}
 
-func (x *GetGoalProgressReq) ProtoReflect() protoreflect.Message {
+func (x *GetSpendingByCategoryReq) ProtoReflect() protoreflect.Message {
 	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -515,28 +518,43 @@ func (x *GetGoalProgressReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGoalProgressReq.ProtoReflect.Descriptor instead.
-func (*GetGoalProgressReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSpendingByCategoryReq.ProtoReflect.Descriptor instead.
+func (*GetSpendingByCategoryReq) Descriptor() ([]byte, []int) {
 	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetGoalProgressReq) GetUserId() string {
+func (x *GetSpendingByCategoryReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type GetGoalProgressResp struct {
+func (x *GetSpendingByCategoryReq) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetSpendingByCategoryReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type GetSpendingByCategoryResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GoalProgress []*GoalProgress `protobuf:"bytes,1,rep,name=goal_progress,json=goalProgress,proto3" json:"goal_progress,omitempty"`
+	Categories   []*CategorySpending `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	BaseCurrency string              `protobuf:"bytes,2,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
 }
 
-func (x *GetGoalProgressResp) Reset() {
-	*x = GetGoalProgressResp{}
+func (x *GetSpendingByCategoryResp) Reset() {
+	*x = GetSpendingByCategoryResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -544,13 +562,13 @@ func (x *GetGoalProgressResp) Reset() {
 	}
 }
 
-func (x *GetGoalProgressResp) String() string {
+func (x *GetSpendingByCategoryResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGoalProgressResp) ProtoMessage() {}
+func (*GetSpendingByCategoryResp) ProtoMessage() {}
 
-func (x *GetGoalProgressResp) ProtoReflect() protoreflect.Message {
+func (x *GetSpendingByCategoryResp) ProtoReflect() protoreflect.Message {
 	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -562,32 +580,42 @@ func (x *GetGoalProgressResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGoalProgressResp.ProtoReflect.Descriptor instead.
-func (*GetGoalProgressResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSpendingByCategoryResp.ProtoReflect.Descriptor instead.
+func (*GetSpendingByCategoryResp) Descriptor() ([]byte, []int) {
 	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetGoalProgressResp) GetGoalProgress() []*GoalProgress {
+func (x *GetSpendingByCategoryResp) GetCategories() []*CategorySpending {
 	if x != nil {
-		return x.GoalProgress
+		return x.Categories
 	}
 	return nil
 }
 
-type GoalProgress struct {
+func (x *GetSpendingByCategoryResp) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+type CategorySpending struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	TargetAmount  float64 `protobuf:"fixed64,3,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
-	CurrentAmount float64 `protobuf:"fixed64,4,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
-	Progress      float64 `protobuf:"fixed64,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	CategoryId string `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId   string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Faqat shu kategoriyaning o'z xarajati
+	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Barcha ichki kategoriyalar bilan birga
+	Total             int64 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	TransactionsCount int64 `protobuf:"varint,6,opt,name=transactions_count,json=transactionsCount,proto3" json:"transactions_count,omitempty"`
 }
 
-func (x *GoalProgress) Reset() {
-	*x = GoalProgress{}
+func (x *CategorySpending) Reset() {
+	*x = CategorySpending{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -595,13 +623,13 @@ func (x *GoalProgress) Reset() {
 	}
 }
 
-func (x *GoalProgress) String() string {
+func (x *CategorySpending) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GoalProgress) ProtoMessage() {}
+func (*CategorySpending) ProtoMessage() {}
 
-func (x *GoalProgress) ProtoReflect() protoreflect.Message {
+func (x *CategorySpending) ProtoReflect() protoreflect.Message {
 	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -613,60 +641,67 @@ func (x *GoalProgress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GoalProgress.ProtoReflect.Descriptor instead.
-func (*GoalProgress) Descriptor() ([]byte, []int) {
+// Deprecated: Use CategorySpending.ProtoReflect.Descriptor instead.
+func (*CategorySpending) Descriptor() ([]byte, []int) {
 	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GoalProgress) GetId() string {
+func (x *CategorySpending) GetCategoryId() string {
 	if x != nil {
-		return x.Id
+		return x.CategoryId
 	}
 	return ""
 }
 
-func (x *GoalProgress) GetName() string {
+func (x *CategorySpending) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GoalProgress) GetTargetAmount() float64 {
+func (x *CategorySpending) GetParentId() string {
 	if x != nil {
-		return x.TargetAmount
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CategorySpending) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *GoalProgress) GetCurrentAmount() float64 {
+func (x *CategorySpending) GetTotal() int64 {
 	if x != nil {
-		return x.CurrentAmount
+		return x.Total
 	}
 	return 0
 }
 
-func (x *GoalProgress) GetProgress() float64 {
+func (x *CategorySpending) GetTransactionsCount() int64 {
 	if x != nil {
-		return x.Progress
+		return x.TransactionsCount
 	}
 	return 0
 }
 
-// Notification
-type SendNotificationReq struct {
+// GET budget-performance
+type GetBudgetPerformanceReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId  string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Type    string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Status  string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	UserId         string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Year           int32  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Month          int32  `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
+	HistoryPeriods int32  `protobuf:"varint,4,opt,name=history_periods,json=historyPeriods,proto3" json:"history_periods,omitempty"`
 }
 
-func (x *SendNotificationReq) Reset() {
-	*x = SendNotificationReq{}
+func (x *GetBudgetPerformanceReq) Reset() {
+	*x = GetBudgetPerformanceReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -674,13 +709,13 @@ func (x *SendNotificationReq) Reset() {
 	}
 }
 
-func (x *SendNotificationReq) String() string {
+func (x *GetBudgetPerformanceReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendNotificationReq) ProtoMessage() {}
+func (*GetBudgetPerformanceReq) ProtoMessage() {}
 
-func (x *SendNotificationReq) ProtoReflect() protoreflect.Message {
+func (x *GetBudgetPerformanceReq) ProtoReflect() protoreflect.Message {
 	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -692,50 +727,53 @@ func (x *SendNotificationReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendNotificationReq.ProtoReflect.Descriptor instead.
-func (*SendNotificationReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBudgetPerformanceReq.ProtoReflect.Descriptor instead.
+func (*GetBudgetPerformanceReq) Descriptor() ([]byte, []int) {
 	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SendNotificationReq) GetUserId() string {
+func (x *GetBudgetPerformanceReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *SendNotificationReq) GetType() string {
+func (x *GetBudgetPerformanceReq) GetYear() int32 {
 	if x != nil {
-		return x.Type
+		return x.Year
 	}
-	return ""
+	return 0
 }
 
-func (x *SendNotificationReq) GetMessage() string {
+func (x *GetBudgetPerformanceReq) GetMonth() int32 {
 	if x != nil {
-		return x.Message
+		return x.Month
 	}
-	return ""
+	return 0
 }
 
-func (x *SendNotificationReq) GetStatus() string {
+func (x *GetBudgetPerformanceReq) GetHistoryPeriods() int32 {
 	if x != nil {
-		return x.Status
+		return x.HistoryPeriods
 	}
-	return ""
+	return 0
 }
 
-type SendNotificationResp struct {
+type GetBudgetPerformanceResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status  string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	UserId                string               `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Year                  int32                `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Month                 int32                `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
+	BudgetPerformanceList []*BudgetPerformance `protobuf:"bytes,4,rep,name=budget_performance_list,json=budgetPerformanceList,proto3" json:"budget_performance_list,omitempty"`
+	BaseCurrency          string               `protobuf:"bytes,5,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
 }
 
-func (x *SendNotificationResp) Reset() {
-	*x = SendNotificationResp{}
+func (x *GetBudgetPerformanceResp) Reset() {
+	*x = GetBudgetPerformanceResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -743,13 +781,13 @@ func (x *SendNotificationResp) Reset() {
 	}
 }
 
-func (x *SendNotificationResp) String() string {
+func (x *GetBudgetPerformanceResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendNotificationResp) ProtoMessage() {}
+func (*GetBudgetPerformanceResp) ProtoMessage() {}
 
-func (x *SendNotificationResp) ProtoReflect() protoreflect.Message {
+func (x *GetBudgetPerformanceResp) ProtoReflect() protoreflect.Message {
 	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -761,35 +799,63 @@ func (x *SendNotificationResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendNotificationResp.ProtoReflect.Descriptor instead.
-func (*SendNotificationResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBudgetPerformanceResp.ProtoReflect.Descriptor instead.
+func (*GetBudgetPerformanceResp) Descriptor() ([]byte, []int) {
 	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SendNotificationResp) GetStatus() string {
+func (x *GetBudgetPerformanceResp) GetUserId() string {
 	if x != nil {
-		return x.Status
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *SendNotificationResp) GetMessage() string {
+func (x *GetBudgetPerformanceResp) GetYear() int32 {
 	if x != nil {
-		return x.Message
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetBudgetPerformanceResp) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *GetBudgetPerformanceResp) GetBudgetPerformanceList() []*BudgetPerformance {
+	if x != nil {
+		return x.BudgetPerformanceList
+	}
+	return nil
+}
+
+func (x *GetBudgetPerformanceResp) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
 	}
 	return ""
 }
 
-type GetNotificationReq struct {
+type BudgetPerformance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    string          `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Target        int64           `protobuf:"varint,5,opt,name=target,proto3" json:"target,omitempty"`
+	Actual        int64           `protobuf:"varint,6,opt,name=actual,proto3" json:"actual,omitempty"`
+	Progress      int64           `protobuf:"varint,7,opt,name=progress,proto3" json:"progress,omitempty"`
+	BudgetId      string          `protobuf:"bytes,8,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	CarriedAmount int64           `protobuf:"varint,9,opt,name=carried_amount,json=carriedAmount,proto3" json:"carried_amount,omitempty"`
+	History       []*BudgetPeriod `protobuf:"bytes,10,rep,name=history,proto3" json:"history,omitempty"`
+	Trend         float64         `protobuf:"fixed64,11,opt,name=trend,proto3" json:"trend,omitempty"`
 }
 
-func (x *GetNotificationReq) Reset() {
-	*x = GetNotificationReq{}
+func (x *BudgetPerformance) Reset() {
+	*x = BudgetPerformance{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -797,13 +863,13 @@ func (x *GetNotificationReq) Reset() {
 	}
 }
 
-func (x *GetNotificationReq) String() string {
+func (x *BudgetPerformance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNotificationReq) ProtoMessage() {}
+func (*BudgetPerformance) ProtoMessage() {}
 
-func (x *GetNotificationReq) ProtoReflect() protoreflect.Message {
+func (x *BudgetPerformance) ProtoReflect() protoreflect.Message {
 	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -815,21 +881,728 @@ func (x *GetNotificationReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNotificationReq.ProtoReflect.Descriptor instead.
-func (*GetNotificationReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use BudgetPerformance.ProtoReflect.Descriptor instead.
+func (*BudgetPerformance) Descriptor() ([]byte, []int) {
 	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetNotificationReq) GetId() string {
+func (x *BudgetPerformance) GetCategoryId() string {
 	if x != nil {
-		return x.Id
+		return x.CategoryId
 	}
 	return ""
 }
 
-type GetNotificationResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *BudgetPerformance) GetTarget() int64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *BudgetPerformance) GetActual() int64 {
+	if x != nil {
+		return x.Actual
+	}
+	return 0
+}
+
+func (x *BudgetPerformance) GetProgress() int64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *BudgetPerformance) GetBudgetId() string {
+	if x != nil {
+		return x.BudgetId
+	}
+	return ""
+}
+
+func (x *BudgetPerformance) GetCarriedAmount() int64 {
+	if x != nil {
+		return x.CarriedAmount
+	}
+	return 0
+}
+
+func (x *BudgetPerformance) GetHistory() []*BudgetPeriod {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *BudgetPerformance) GetTrend() float64 {
+	if x != nil {
+		return x.Trend
+	}
+	return 0
+}
+
+type BudgetPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BudgetId       string `protobuf:"bytes,1,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	StartDate      string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate        string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Target         int64  `protobuf:"varint,4,opt,name=target,proto3" json:"target,omitempty"`
+	Actual         int64  `protobuf:"varint,5,opt,name=actual,proto3" json:"actual,omitempty"`
+	CarriedForward int64  `protobuf:"varint,6,opt,name=carried_forward,json=carriedForward,proto3" json:"carried_forward,omitempty"`
+}
+
+func (x *BudgetPeriod) Reset() {
+	*x = BudgetPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BudgetPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetPeriod) ProtoMessage() {}
+
+func (x *BudgetPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetPeriod.ProtoReflect.Descriptor instead.
+func (*BudgetPeriod) Descriptor() ([]byte, []int) {
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BudgetPeriod) GetBudgetId() string {
+	if x != nil {
+		return x.BudgetId
+	}
+	return ""
+}
+
+func (x *BudgetPeriod) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *BudgetPeriod) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *BudgetPeriod) GetTarget() int64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *BudgetPeriod) GetActual() int64 {
+	if x != nil {
+		return x.Actual
+	}
+	return 0
+}
+
+func (x *BudgetPeriod) GetCarriedForward() int64 {
+	if x != nil {
+		return x.CarriedForward
+	}
+	return 0
+}
+
+// GET goal-progress
+type GetGoalProgressReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetGoalProgressReq) Reset() {
+	*x = GetGoalProgressReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGoalProgressReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoalProgressReq) ProtoMessage() {}
+
+func (x *GetGoalProgressReq) ProtoReflect() protoreflect.Message {
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoalProgressReq.ProtoReflect.Descriptor instead.
+func (*GetGoalProgressReq) Descriptor() ([]byte, []int) {
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetGoalProgressReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetGoalProgressResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoalProgress []*GoalProgress `protobuf:"bytes,1,rep,name=goal_progress,json=goalProgress,proto3" json:"goal_progress,omitempty"`
+	BaseCurrency string          `protobuf:"bytes,2,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+}
+
+func (x *GetGoalProgressResp) Reset() {
+	*x = GetGoalProgressResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGoalProgressResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoalProgressResp) ProtoMessage() {}
+
+func (x *GetGoalProgressResp) ProtoReflect() protoreflect.Message {
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoalProgressResp.ProtoReflect.Descriptor instead.
+func (*GetGoalProgressResp) Descriptor() ([]byte, []int) {
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetGoalProgressResp) GetGoalProgress() []*GoalProgress {
+	if x != nil {
+		return x.GoalProgress
+	}
+	return nil
+}
+
+func (x *GetGoalProgressResp) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+type GoalProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                          string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                        string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TargetAmount                int64   `protobuf:"varint,6,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	CurrentAmount               int64   `protobuf:"varint,7,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
+	Progress                    float64 `protobuf:"fixed64,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	AtRisk                      bool    `protobuf:"varint,8,opt,name=at_risk,json=atRisk,proto3" json:"at_risk,omitempty"`
+	ProjectedCompletionDate     string  `protobuf:"bytes,9,opt,name=projected_completion_date,json=projectedCompletionDate,proto3" json:"projected_completion_date,omitempty"`
+	RequiredMonthlyContribution int64   `protobuf:"varint,10,opt,name=required_monthly_contribution,json=requiredMonthlyContribution,proto3" json:"required_monthly_contribution,omitempty"`
+}
+
+func (x *GoalProgress) Reset() {
+	*x = GoalProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoalProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalProgress) ProtoMessage() {}
+
+func (x *GoalProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalProgress.ProtoReflect.Descriptor instead.
+func (*GoalProgress) Descriptor() ([]byte, []int) {
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GoalProgress) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GoalProgress) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GoalProgress) GetTargetAmount() int64 {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetCurrentAmount() int64 {
+	if x != nil {
+		return x.CurrentAmount
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetAtRisk() bool {
+	if x != nil {
+		return x.AtRisk
+	}
+	return false
+}
+
+func (x *GoalProgress) GetProjectedCompletionDate() string {
+	if x != nil {
+		return x.ProjectedCompletionDate
+	}
+	return ""
+}
+
+func (x *GoalProgress) GetRequiredMonthlyContribution() int64 {
+	if x != nil {
+		return x.RequiredMonthlyContribution
+	}
+	return 0
+}
+
+// Goal forecast
+type ForecastGoalReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoalId string `protobuf:"bytes,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ForecastGoalReq) Reset() {
+	*x = ForecastGoalReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForecastGoalReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastGoalReq) ProtoMessage() {}
+
+func (x *ForecastGoalReq) ProtoReflect() protoreflect.Message {
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastGoalReq.ProtoReflect.Descriptor instead.
+func (*ForecastGoalReq) Descriptor() ([]byte, []int) {
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ForecastGoalReq) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *ForecastGoalReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ForecastGoalResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoalId                      string `protobuf:"bytes,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	TargetAmount                int64  `protobuf:"varint,2,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	CurrentAmount               int64  `protobuf:"varint,3,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
+	RemainingAmount             int64  `protobuf:"varint,4,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount,omitempty"`
+	Deadline                    string `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	AverageMonthlyContribution  int64  `protobuf:"varint,6,opt,name=average_monthly_contribution,json=averageMonthlyContribution,proto3" json:"average_monthly_contribution,omitempty"`
+	AverageMonthlyNetIncome     int64  `protobuf:"varint,7,opt,name=average_monthly_net_income,json=averageMonthlyNetIncome,proto3" json:"average_monthly_net_income,omitempty"`
+	ProjectedCompletionDate     string `protobuf:"bytes,8,opt,name=projected_completion_date,json=projectedCompletionDate,proto3" json:"projected_completion_date,omitempty"`
+	RequiredMonthlyContribution int64  `protobuf:"varint,9,opt,name=required_monthly_contribution,json=requiredMonthlyContribution,proto3" json:"required_monthly_contribution,omitempty"`
+	AtRisk                      bool   `protobuf:"varint,10,opt,name=at_risk,json=atRisk,proto3" json:"at_risk,omitempty"`
+	BaseCurrency                string `protobuf:"bytes,11,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+}
+
+func (x *ForecastGoalResp) Reset() {
+	*x = ForecastGoalResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForecastGoalResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastGoalResp) ProtoMessage() {}
+
+func (x *ForecastGoalResp) ProtoReflect() protoreflect.Message {
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastGoalResp.ProtoReflect.Descriptor instead.
+func (*ForecastGoalResp) Descriptor() ([]byte, []int) {
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ForecastGoalResp) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *ForecastGoalResp) GetTargetAmount() int64 {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return 0
+}
+
+func (x *ForecastGoalResp) GetCurrentAmount() int64 {
+	if x != nil {
+		return x.CurrentAmount
+	}
+	return 0
+}
+
+func (x *ForecastGoalResp) GetRemainingAmount() int64 {
+	if x != nil {
+		return x.RemainingAmount
+	}
+	return 0
+}
+
+func (x *ForecastGoalResp) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *ForecastGoalResp) GetAverageMonthlyContribution() int64 {
+	if x != nil {
+		return x.AverageMonthlyContribution
+	}
+	return 0
+}
+
+func (x *ForecastGoalResp) GetAverageMonthlyNetIncome() int64 {
+	if x != nil {
+		return x.AverageMonthlyNetIncome
+	}
+	return 0
+}
+
+func (x *ForecastGoalResp) GetProjectedCompletionDate() string {
+	if x != nil {
+		return x.ProjectedCompletionDate
+	}
+	return ""
+}
+
+func (x *ForecastGoalResp) GetRequiredMonthlyContribution() int64 {
+	if x != nil {
+		return x.RequiredMonthlyContribution
+	}
+	return 0
+}
+
+func (x *ForecastGoalResp) GetAtRisk() bool {
+	if x != nil {
+		return x.AtRisk
+	}
+	return false
+}
+
+func (x *ForecastGoalResp) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+// Notification
+type SendNotificationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type    string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Status  string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SendNotificationReq) Reset() {
+	*x = SendNotificationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendNotificationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationReq) ProtoMessage() {}
+
+func (x *SendNotificationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationReq.ProtoReflect.Descriptor instead.
+func (*SendNotificationReq) Descriptor() ([]byte, []int) {
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SendNotificationReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SendNotificationReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SendNotificationReq) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendNotificationReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type SendNotificationResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SendNotificationResp) Reset() {
+	*x = SendNotificationResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendNotificationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationResp) ProtoMessage() {}
+
+func (x *SendNotificationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationResp.ProtoReflect.Descriptor instead.
+func (*SendNotificationResp) Descriptor() ([]byte, []int) {
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SendNotificationResp) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SendNotificationResp) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetNotificationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetNotificationReq) Reset() {
+	*x = GetNotificationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationReq) ProtoMessage() {}
+
+func (x *GetNotificationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationReq.ProtoReflect.Descriptor instead.
+func (*GetNotificationReq) Descriptor() ([]byte, []int) {
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetNotificationReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetNotificationReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetNotificationResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -843,7 +1616,7 @@ type GetNotificationResp struct {
 func (x *GetNotificationResp) Reset() {
 	*x = GetNotificationResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[13]
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -856,7 +1629,7 @@ func (x *GetNotificationResp) String() string {
 func (*GetNotificationResp) ProtoMessage() {}
 
 func (x *GetNotificationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[13]
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -869,7 +1642,7 @@ func (x *GetNotificationResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNotificationResp.ProtoReflect.Descriptor instead.
 func (*GetNotificationResp) Descriptor() ([]byte, []int) {
-	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{13}
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetNotificationResp) GetId() string {
@@ -920,12 +1693,16 @@ type GetNotificationsListReq struct {
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// limit berilmasa, barcha bildirishnomalar qaytariladi
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// page o'rniga ishlatiladi: oldingi javobdagi next_page_token
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (x *GetNotificationsListReq) Reset() {
 	*x = GetNotificationsListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[14]
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -938,7 +1715,7 @@ func (x *GetNotificationsListReq) String() string {
 func (*GetNotificationsListReq) ProtoMessage() {}
 
 func (x *GetNotificationsListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[14]
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -951,7 +1728,7 @@ func (x *GetNotificationsListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNotificationsListReq.ProtoReflect.Descriptor instead.
 func (*GetNotificationsListReq) Descriptor() ([]byte, []int) {
-	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{14}
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetNotificationsListReq) GetUserId() string {
@@ -961,18 +1738,34 @@ func (x *GetNotificationsListReq) GetUserId() string {
 	return ""
 }
 
+func (x *GetNotificationsListReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetNotificationsListReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type GetNotificationsListResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	NotificationList []*Notification `protobuf:"bytes,1,rep,name=notification_list,json=notificationList,proto3" json:"notification_list,omitempty"`
+	// Keyingi sahifa bo'lmasa bo'sh
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *GetNotificationsListResp) Reset() {
 	*x = GetNotificationsListResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[15]
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -985,7 +1778,149 @@ func (x *GetNotificationsListResp) String() string {
 func (*GetNotificationsListResp) ProtoMessage() {}
 
 func (x *GetNotificationsListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[15]
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsListResp.ProtoReflect.Descriptor instead.
+func (*GetNotificationsListResp) Descriptor() ([]byte, []int) {
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetNotificationsListResp) GetNotificationList() []*Notification {
+	if x != nil {
+		return x.NotificationList
+	}
+	return nil
+}
+
+func (x *GetNotificationsListResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	IsRead    bool   `protobuf:"varint,5,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
+	CreatedAt string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Notification) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Notification) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Notification) GetIsRead() bool {
+	if x != nil {
+		return x.IsRead
+	}
+	return false
+}
+
+func (x *Notification) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SetBudgetAlertThresholdsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     string  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Thresholds []int32 `protobuf:"varint,2,rep,packed,name=thresholds,proto3" json:"thresholds,omitempty"`
+}
+
+func (x *SetBudgetAlertThresholdsReq) Reset() {
+	*x = SetBudgetAlertThresholdsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBudgetAlertThresholdsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBudgetAlertThresholdsReq) ProtoMessage() {}
+
+func (x *SetBudgetAlertThresholdsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -996,48 +1931,51 @@ func (x *GetNotificationsListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNotificationsListResp.ProtoReflect.Descriptor instead.
-func (*GetNotificationsListResp) Descriptor() ([]byte, []int) {
-	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SetBudgetAlertThresholdsReq.ProtoReflect.Descriptor instead.
+func (*SetBudgetAlertThresholdsReq) Descriptor() ([]byte, []int) {
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetNotificationsListResp) GetNotificationList() []*Notification {
+func (x *SetBudgetAlertThresholdsReq) GetUserId() string {
 	if x != nil {
-		return x.NotificationList
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetBudgetAlertThresholdsReq) GetThresholds() []int32 {
+	if x != nil {
+		return x.Thresholds
 	}
 	return nil
 }
 
-type Notification struct {
+type SetBudgetAlertThresholdsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	IsRead    bool   `protobuf:"varint,5,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
-	CreatedAt string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Status  string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *Notification) Reset() {
-	*x = Notification{}
+func (x *SetBudgetAlertThresholdsResp) Reset() {
+	*x = SetBudgetAlertThresholdsResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[16]
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Notification) String() string {
+func (x *SetBudgetAlertThresholdsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Notification) ProtoMessage() {}
+func (*SetBudgetAlertThresholdsResp) ProtoMessage() {}
 
-func (x *Notification) ProtoReflect() protoreflect.Message {
-	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[16]
+func (x *SetBudgetAlertThresholdsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1048,49 +1986,21 @@ func (x *Notification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
-func (*Notification) Descriptor() ([]byte, []int) {
-	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *Notification) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Notification) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *Notification) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use SetBudgetAlertThresholdsResp.ProtoReflect.Descriptor instead.
+func (*SetBudgetAlertThresholdsResp) Descriptor() ([]byte, []int) {
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *Notification) GetStatus() string {
+func (x *SetBudgetAlertThresholdsResp) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *Notification) GetIsRead() bool {
-	if x != nil {
-		return x.IsRead
-	}
-	return false
-}
-
-func (x *Notification) GetCreatedAt() string {
+func (x *SetBudgetAlertThresholdsResp) GetMessage() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Message
 	}
 	return ""
 }
@@ -1100,13 +2010,14 @@ type DeleteNotificationReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
 func (x *DeleteNotificationReq) Reset() {
 	*x = DeleteNotificationReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[17]
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1119,7 +2030,7 @@ func (x *DeleteNotificationReq) String() string {
 func (*DeleteNotificationReq) ProtoMessage() {}
 
 func (x *DeleteNotificationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[17]
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1132,7 +2043,7 @@ func (x *DeleteNotificationReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNotificationReq.ProtoReflect.Descriptor instead.
 func (*DeleteNotificationReq) Descriptor() ([]byte, []int) {
-	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{17}
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *DeleteNotificationReq) GetId() string {
@@ -1142,6 +2053,13 @@ func (x *DeleteNotificationReq) GetId() string {
 	return ""
 }
 
+func (x *DeleteNotificationReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type DeleteNotificationResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1154,7 +2072,7 @@ type DeleteNotificationResp struct {
 func (x *DeleteNotificationResp) Reset() {
 	*x = DeleteNotificationResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[18]
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1167,7 +2085,7 @@ func (x *DeleteNotificationResp) String() string {
 func (*DeleteNotificationResp) ProtoMessage() {}
 
 func (x *DeleteNotificationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[18]
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1180,7 +2098,7 @@ func (x *DeleteNotificationResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNotificationResp.ProtoReflect.Descriptor instead.
 func (*DeleteNotificationResp) Descriptor() ([]byte, []int) {
-	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{18}
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DeleteNotificationResp) GetStatus() string {
@@ -1204,12 +2122,13 @@ type UpdateNotificationReq struct {
 
 	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	IsRead bool   `protobuf:"varint,2,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
 func (x *UpdateNotificationReq) Reset() {
 	*x = UpdateNotificationReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[19]
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1222,7 +2141,7 @@ func (x *UpdateNotificationReq) String() string {
 func (*UpdateNotificationReq) ProtoMessage() {}
 
 func (x *UpdateNotificationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[19]
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1235,7 +2154,7 @@ func (x *UpdateNotificationReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNotificationReq.ProtoReflect.Descriptor instead.
 func (*UpdateNotificationReq) Descriptor() ([]byte, []int) {
-	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{19}
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UpdateNotificationReq) GetId() string {
@@ -1252,6 +2171,13 @@ func (x *UpdateNotificationReq) GetIsRead() bool {
 	return false
 }
 
+func (x *UpdateNotificationReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type UpdateNotificationResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1264,7 +2190,7 @@ type UpdateNotificationResp struct {
 func (x *UpdateNotificationResp) Reset() {
 	*x = UpdateNotificationResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[20]
+		mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1277,7 +2203,7 @@ func (x *UpdateNotificationResp) String() string {
 func (*UpdateNotificationResp) ProtoMessage() {}
 
 func (x *UpdateNotificationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[20]
+	mi := &file_budgeting_service_reporting_and_notifications_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1290,7 +2216,7 @@ func (x *UpdateNotificationResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNotificationResp.ProtoReflect.Descriptor instead.
 func (*UpdateNotificationResp) Descriptor() ([]byte, []int) {
-	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{20}
+	return file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UpdateNotificationResp) GetStatus() string {
@@ -1320,199 +2246,382 @@ var file_budgeting_service_reporting_and_notifications_proto_rawDesc = []byte{
 	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x79, 0x65, 0x61,
 	0x72, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x79, 0x65, 0x61, 0x72, 0x6c,
 	0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x22, 0x67, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x53, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x79, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x79, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f,
-	0x6e, 0x74, 0x68, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x6f, 0x6e,
-	0x74, 0x68, 0x6c, 0x79, 0x22, 0x5f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
-	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x79, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x18, 0x02, 0x20,
+	0x28, 0x08, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x22, 0x92, 0x01, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x79, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x08, 0x52, 0x06, 0x79, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d,
 	0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x6f,
-	0x6e, 0x74, 0x68, 0x6c, 0x79, 0x22, 0x6a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6f,
-	0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x21, 0x0a, 0x0c,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x6e, 0x74, 0x68, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61,
+	0x73, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02,
+	0x22, 0x5f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
 	0x16, 0x0a, 0x06, 0x79, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
 	0x06, 0x79, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
 	0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c,
-	0x79, 0x22, 0x5c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65,
-	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e,
-	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x22,
-	0xc0, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e,
-	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12,
-	0x61, 0x0a, 0x17, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x29, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
-	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x15, 0x62, 0x75, 0x64,
-	0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x11, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x2d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c,
+	0x79, 0x22, 0x95, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x79, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x79, 0x65,
+	0x61, 0x72, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x12, 0x23,
+	0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x7c, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x74, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x37, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x61, 0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x62, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x66, 0x0a,
+	0x0b, 0x54, 0x61, 0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6d, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x48, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x22, 0xc1, 0x01, 0x0a, 0x10, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65,
+	0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x22, 0xe5, 0x01,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x61, 0x0a,
+	0x17, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65,
+	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x15, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xac, 0x02, 0x0a, 0x11, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x64,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63,
+	0x61, 0x72, 0x72, 0x69, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x07,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x72, 0x65, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x72, 0x65,
+	0x6e, 0x64, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04,
+	0x08, 0x04, 0x10, 0x05, 0x22, 0xbe, 0x01, 0x0a, 0x0c, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x64, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x22, 0x2d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c,
 	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75,
 	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0x60, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x50,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x0d, 0x67,
-	0x6f, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x6f, 0x61, 0x6c,
-	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x67, 0x6f, 0x61, 0x6c, 0x50, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x0c, 0x47, 0x6f, 0x61, 0x6c, 0x50,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x74, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x48, 0x0a, 0x14, 0x53, 0x65, 0x6e,
-	0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x0d,
+	0x67, 0x6f, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x6f, 0x61,
+	0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x67, 0x6f, 0x61, 0x6c, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x62, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xbf, 0x02, 0x0a,
+	0x0c, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x74, 0x5f,
+	0x72, 0x69, 0x73, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x74, 0x52, 0x69,
+	0x73, 0x6b, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x42,
+	0x0a, 0x1d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4d,
+	0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x43,
+	0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6f, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x6f, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0xfb, 0x03, 0x0a, 0x10, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6f, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x6f, 0x61, 0x6c, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x40, 0x0a, 0x1c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x1a, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x6e,
+	0x63, 0x6f, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x42, 0x0a, 0x1d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x74, 0x5f, 0x72, 0x69, 0x73, 0x6b, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x74, 0x52, 0x69, 0x73, 0x6b, 0x12, 0x23, 0x0a, 0x0d,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x22, 0x74, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
 	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
 	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x72, 0x65,
-	0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
-	0x32, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x51, 0x0a, 0x11, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x10, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
-	0x73, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f,
-	0x72, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x52, 0x65,
-	0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4a, 0x0a, 0x16, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x40, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x22, 0x4a, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x32, 0x8a, 0x08, 0x0a, 0x1c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69,
-	0x6e, 0x67, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x65, 0x70, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e,
-	0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x28,
-	0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x70, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x2a, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e,
-	0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
-	0x71, 0x1a, 0x2b, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
-	0x63, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x79,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x48, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x3d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0xa3, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x67, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x95, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x11,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x56, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x22, 0x50,
+	0x0a, 0x1c, 0x53, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x40, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x4a, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59,
+	0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x16, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0xe2, 0x0b, 0x0a, 0x1c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69,
 	0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a,
+	0x28, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x2a, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x6d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x79,
+	0x54, 0x61, 0x67, 0x12, 0x2b, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71,
+	0x1a, 0x2c, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x7c,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x30, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
 	0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x0c, 0x47, 0x6f, 0x61,
-	0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x2e, 0x72, 0x65, 0x70, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x31, 0x2e, 0x72, 0x65, 0x70, 0x6f,
 	0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e,
+	0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x79,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x79, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e,
 	0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47,
-	0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x6d, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69,
-	0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x53, 0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f,
-	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x6e,
-	0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x78, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x72, 0x65, 0x70, 0x6f,
+	0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x0c, 0x47, 0x6f, 0x61, 0x6c, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x6f, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x61, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x47, 0x6f, 0x61, 0x6c,
+	0x12, 0x27, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x73, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x47, 0x6f, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x6d, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x78, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x2e, 0x72, 0x65, 0x70, 0x6f,
 	0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a,
-	0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x72, 0x65, 0x70,
+	0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x72, 0x65, 0x70,
 	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e,
+	0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2a, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d,
+	0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e,
 	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
 	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x12,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a,
+	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e,
 	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65,
 	0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x1a, 0x2e, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x42, 0x15, 0x5a, 0x13, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x62,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x70, 0x12, 0x85, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12,
+	0x33, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x34, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65,
+	0x74, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x15, 0x5a, 0x13, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1527,57 +2636,79 @@ func file_budgeting_service_reporting_and_notifications_proto_rawDescGZIP() []by
 	return file_budgeting_service_reporting_and_notifications_proto_rawDescData
 }
 
-var file_budgeting_service_reporting_and_notifications_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_budgeting_service_reporting_and_notifications_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_budgeting_service_reporting_and_notifications_proto_goTypes = []any{
-	(*GetSependingReq)(nil),          // 0: reporting_notification.GetSependingReq
-	(*GetSependingResp)(nil),         // 1: reporting_notification.GetSependingResp
-	(*GetIncomeReportReq)(nil),       // 2: reporting_notification.GetIncomeReportReq
-	(*GetIncomeReportResp)(nil),      // 3: reporting_notification.GetIncomeReportResp
-	(*GetBudgetPerformanceReq)(nil),  // 4: reporting_notification.GetBudgetPerformanceReq
-	(*GetBudgetPerformanceResp)(nil), // 5: reporting_notification.GetBudgetPerformanceResp
-	(*BudgetPerformance)(nil),        // 6: reporting_notification.BudgetPerformance
-	(*GetGoalProgressReq)(nil),       // 7: reporting_notification.GetGoalProgressReq
-	(*GetGoalProgressResp)(nil),      // 8: reporting_notification.GetGoalProgressResp
-	(*GoalProgress)(nil),             // 9: reporting_notification.GoalProgress
-	(*SendNotificationReq)(nil),      // 10: reporting_notification.SendNotificationReq
-	(*SendNotificationResp)(nil),     // 11: reporting_notification.SendNotificationResp
-	(*GetNotificationReq)(nil),       // 12: reporting_notification.GetNotificationReq
-	(*GetNotificationResp)(nil),      // 13: reporting_notification.GetNotificationResp
-	(*GetNotificationsListReq)(nil),  // 14: reporting_notification.GetNotificationsListReq
-	(*GetNotificationsListResp)(nil), // 15: reporting_notification.GetNotificationsListResp
-	(*Notification)(nil),             // 16: reporting_notification.Notification
-	(*DeleteNotificationReq)(nil),    // 17: reporting_notification.DeleteNotificationReq
-	(*DeleteNotificationResp)(nil),   // 18: reporting_notification.DeleteNotificationResp
-	(*UpdateNotificationReq)(nil),    // 19: reporting_notification.UpdateNotificationReq
-	(*UpdateNotificationResp)(nil),   // 20: reporting_notification.UpdateNotificationResp
+	(*GetSependingReq)(nil),              // 0: reporting_notification.GetSependingReq
+	(*GetSependingResp)(nil),             // 1: reporting_notification.GetSependingResp
+	(*GetIncomeReportReq)(nil),           // 2: reporting_notification.GetIncomeReportReq
+	(*GetIncomeReportResp)(nil),          // 3: reporting_notification.GetIncomeReportResp
+	(*GetSpendingByTagReq)(nil),          // 4: reporting_notification.GetSpendingByTagReq
+	(*GetSpendingByTagResp)(nil),         // 5: reporting_notification.GetSpendingByTagResp
+	(*TagSpending)(nil),                  // 6: reporting_notification.TagSpending
+	(*GetSpendingByCategoryReq)(nil),     // 7: reporting_notification.GetSpendingByCategoryReq
+	(*GetSpendingByCategoryResp)(nil),    // 8: reporting_notification.GetSpendingByCategoryResp
+	(*CategorySpending)(nil),             // 9: reporting_notification.CategorySpending
+	(*GetBudgetPerformanceReq)(nil),      // 10: reporting_notification.GetBudgetPerformanceReq
+	(*GetBudgetPerformanceResp)(nil),     // 11: reporting_notification.GetBudgetPerformanceResp
+	(*BudgetPerformance)(nil),            // 12: reporting_notification.BudgetPerformance
+	(*BudgetPeriod)(nil),                 // 13: reporting_notification.BudgetPeriod
+	(*GetGoalProgressReq)(nil),           // 14: reporting_notification.GetGoalProgressReq
+	(*GetGoalProgressResp)(nil),          // 15: reporting_notification.GetGoalProgressResp
+	(*GoalProgress)(nil),                 // 16: reporting_notification.GoalProgress
+	(*ForecastGoalReq)(nil),              // 17: reporting_notification.ForecastGoalReq
+	(*ForecastGoalResp)(nil),             // 18: reporting_notification.ForecastGoalResp
+	(*SendNotificationReq)(nil),          // 19: reporting_notification.SendNotificationReq
+	(*SendNotificationResp)(nil),         // 20: reporting_notification.SendNotificationResp
+	(*GetNotificationReq)(nil),           // 21: reporting_notification.GetNotificationReq
+	(*GetNotificationResp)(nil),          // 22: reporting_notification.GetNotificationResp
+	(*GetNotificationsListReq)(nil),      // 23: reporting_notification.GetNotificationsListReq
+	(*GetNotificationsListResp)(nil),     // 24: reporting_notification.GetNotificationsListResp
+	(*Notification)(nil),                 // 25: reporting_notification.Notification
+	(*SetBudgetAlertThresholdsReq)(nil),  // 26: reporting_notification.SetBudgetAlertThresholdsReq
+	(*SetBudgetAlertThresholdsResp)(nil), // 27: reporting_notification.SetBudgetAlertThresholdsResp
+	(*DeleteNotificationReq)(nil),        // 28: reporting_notification.DeleteNotificationReq
+	(*DeleteNotificationResp)(nil),       // 29: reporting_notification.DeleteNotificationResp
+	(*UpdateNotificationReq)(nil),        // 30: reporting_notification.UpdateNotificationReq
+	(*UpdateNotificationResp)(nil),       // 31: reporting_notification.UpdateNotificationResp
 }
 var file_budgeting_service_reporting_and_notifications_proto_depIdxs = []int32{
-	6,  // 0: reporting_notification.GetBudgetPerformanceResp.budget_performance_list:type_name -> reporting_notification.BudgetPerformance
-	9,  // 1: reporting_notification.GetGoalProgressResp.goal_progress:type_name -> reporting_notification.GoalProgress
-	16, // 2: reporting_notification.GetNotificationsListResp.notification_list:type_name -> reporting_notification.Notification
-	0,  // 3: reporting_notification.ReportingNotificationService.GetSepending:input_type -> reporting_notification.GetSependingReq
-	2,  // 4: reporting_notification.ReportingNotificationService.GetIncome:input_type -> reporting_notification.GetIncomeReportReq
-	4,  // 5: reporting_notification.ReportingNotificationService.GetBudgetPerformance:input_type -> reporting_notification.GetBudgetPerformanceReq
-	7,  // 6: reporting_notification.ReportingNotificationService.GoalProgress:input_type -> reporting_notification.GetGoalProgressReq
-	10, // 7: reporting_notification.ReportingNotificationService.SendNotification:input_type -> reporting_notification.SendNotificationReq
-	14, // 8: reporting_notification.ReportingNotificationService.GetNotificationList:input_type -> reporting_notification.GetNotificationsListReq
-	12, // 9: reporting_notification.ReportingNotificationService.GetNotification:input_type -> reporting_notification.GetNotificationReq
-	19, // 10: reporting_notification.ReportingNotificationService.UpdateNotification:input_type -> reporting_notification.UpdateNotificationReq
-	17, // 11: reporting_notification.ReportingNotificationService.DeleteNotification:input_type -> reporting_notification.DeleteNotificationReq
-	1,  // 12: reporting_notification.ReportingNotificationService.GetSepending:output_type -> reporting_notification.GetSependingResp
-	3,  // 13: reporting_notification.ReportingNotificationService.GetIncome:output_type -> reporting_notification.GetIncomeReportResp
-	5,  // 14: reporting_notification.ReportingNotificationService.GetBudgetPerformance:output_type -> reporting_notification.GetBudgetPerformanceResp
-	8,  // 15: reporting_notification.ReportingNotificationService.GoalProgress:output_type -> reporting_notification.GetGoalProgressResp
-	11, // 16: reporting_notification.ReportingNotificationService.SendNotification:output_type -> reporting_notification.SendNotificationResp
-	15, // 17: reporting_notification.ReportingNotificationService.GetNotificationList:output_type -> reporting_notification.GetNotificationsListResp
-	13, // 18: reporting_notification.ReportingNotificationService.GetNotification:output_type -> reporting_notification.GetNotificationResp
-	20, // 19: reporting_notification.ReportingNotificationService.UpdateNotification:output_type -> reporting_notification.UpdateNotificationResp
-	18, // 20: reporting_notification.ReportingNotificationService.DeleteNotification:output_type -> reporting_notification.DeleteNotificationResp
-	12, // [12:21] is the sub-list for method output_type
-	3,  // [3:12] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	6,  // 0: reporting_notification.GetSpendingByTagResp.tags:type_name -> reporting_notification.TagSpending
+	9,  // 1: reporting_notification.GetSpendingByCategoryResp.categories:type_name -> reporting_notification.CategorySpending
+	12, // 2: reporting_notification.GetBudgetPerformanceResp.budget_performance_list:type_name -> reporting_notification.BudgetPerformance
+	13, // 3: reporting_notification.BudgetPerformance.history:type_name -> reporting_notification.BudgetPeriod
+	16, // 4: reporting_notification.GetGoalProgressResp.goal_progress:type_name -> reporting_notification.GoalProgress
+	25, // 5: reporting_notification.GetNotificationsListResp.notification_list:type_name -> reporting_notification.Notification
+	0,  // 6: reporting_notification.ReportingNotificationService.GetSepending:input_type -> reporting_notification.GetSependingReq
+	2,  // 7: reporting_notification.ReportingNotificationService.GetIncome:input_type -> reporting_notification.GetIncomeReportReq
+	4,  // 8: reporting_notification.ReportingNotificationService.GetSpendingByTag:input_type -> reporting_notification.GetSpendingByTagReq
+	7,  // 9: reporting_notification.ReportingNotificationService.GetSpendingByCategory:input_type -> reporting_notification.GetSpendingByCategoryReq
+	10, // 10: reporting_notification.ReportingNotificationService.GetBudgetPerformance:input_type -> reporting_notification.GetBudgetPerformanceReq
+	14, // 11: reporting_notification.ReportingNotificationService.GoalProgress:input_type -> reporting_notification.GetGoalProgressReq
+	17, // 12: reporting_notification.ReportingNotificationService.ForecastGoal:input_type -> reporting_notification.ForecastGoalReq
+	19, // 13: reporting_notification.ReportingNotificationService.SendNotification:input_type -> reporting_notification.SendNotificationReq
+	23, // 14: reporting_notification.ReportingNotificationService.GetNotificationList:input_type -> reporting_notification.GetNotificationsListReq
+	21, // 15: reporting_notification.ReportingNotificationService.GetNotification:input_type -> reporting_notification.GetNotificationReq
+	30, // 16: reporting_notification.ReportingNotificationService.UpdateNotification:input_type -> reporting_notification.UpdateNotificationReq
+	28, // 17: reporting_notification.ReportingNotificationService.DeleteNotification:input_type -> reporting_notification.DeleteNotificationReq
+	26, // 18: reporting_notification.ReportingNotificationService.SetBudgetAlertThresholds:input_type -> reporting_notification.SetBudgetAlertThresholdsReq
+	1,  // 19: reporting_notification.ReportingNotificationService.GetSepending:output_type -> reporting_notification.GetSependingResp
+	3,  // 20: reporting_notification.ReportingNotificationService.GetIncome:output_type -> reporting_notification.GetIncomeReportResp
+	5,  // 21: reporting_notification.ReportingNotificationService.GetSpendingByTag:output_type -> reporting_notification.GetSpendingByTagResp
+	8,  // 22: reporting_notification.ReportingNotificationService.GetSpendingByCategory:output_type -> reporting_notification.GetSpendingByCategoryResp
+	11, // 23: reporting_notification.ReportingNotificationService.GetBudgetPerformance:output_type -> reporting_notification.GetBudgetPerformanceResp
+	15, // 24: reporting_notification.ReportingNotificationService.GoalProgress:output_type -> reporting_notification.GetGoalProgressResp
+	18, // 25: reporting_notification.ReportingNotificationService.ForecastGoal:output_type -> reporting_notification.ForecastGoalResp
+	20, // 26: reporting_notification.ReportingNotificationService.SendNotification:output_type -> reporting_notification.SendNotificationResp
+	24, // 27: reporting_notification.ReportingNotificationService.GetNotificationList:output_type -> reporting_notification.GetNotificationsListResp
+	22, // 28: reporting_notification.ReportingNotificationService.GetNotification:output_type -> reporting_notification.GetNotificationResp
+	31, // 29: reporting_notification.ReportingNotificationService.UpdateNotification:output_type -> reporting_notification.UpdateNotificationResp
+	29, // 30: reporting_notification.ReportingNotificationService.DeleteNotification:output_type -> reporting_notification.DeleteNotificationResp
+	27, // 31: reporting_notification.ReportingNotificationService.SetBudgetAlertThresholds:output_type -> reporting_notification.SetBudgetAlertThresholdsResp
+	19, // [19:32] is the sub-list for method output_type
+	6,  // [6:19] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_budgeting_service_reporting_and_notifications_proto_init() }
@@ -1635,7 +2766,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*GetBudgetPerformanceReq); i {
+			switch v := v.(*GetSpendingByTagReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1647,7 +2778,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*GetBudgetPerformanceResp); i {
+			switch v := v.(*GetSpendingByTagResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1659,7 +2790,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*BudgetPerformance); i {
+			switch v := v.(*TagSpending); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1671,7 +2802,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*GetGoalProgressReq); i {
+			switch v := v.(*GetSpendingByCategoryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1683,7 +2814,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*GetGoalProgressResp); i {
+			switch v := v.(*GetSpendingByCategoryResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1695,7 +2826,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*GoalProgress); i {
+			switch v := v.(*CategorySpending); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1707,7 +2838,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*SendNotificationReq); i {
+			switch v := v.(*GetBudgetPerformanceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1719,7 +2850,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*SendNotificationResp); i {
+			switch v := v.(*GetBudgetPerformanceResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1731,7 +2862,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*GetNotificationReq); i {
+			switch v := v.(*BudgetPerformance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1743,7 +2874,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*GetNotificationResp); i {
+			switch v := v.(*BudgetPeriod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1755,7 +2886,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*GetNotificationsListReq); i {
+			switch v := v.(*GetGoalProgressReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1767,7 +2898,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*GetNotificationsListResp); i {
+			switch v := v.(*GetGoalProgressResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1779,7 +2910,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*Notification); i {
+			switch v := v.(*GoalProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1791,7 +2922,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteNotificationReq); i {
+			switch v := v.(*ForecastGoalReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1803,7 +2934,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteNotificationResp); i {
+			switch v := v.(*ForecastGoalResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1815,7 +2946,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateNotificationReq); i {
+			switch v := v.(*SendNotificationReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1827,6 +2958,138 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			}
 		}
 		file_budgeting_service_reporting_and_notifications_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*SendNotificationResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_budgeting_service_reporting_and_notifications_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*GetNotificationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_budgeting_service_reporting_and_notifications_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*GetNotificationResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_budgeting_service_reporting_and_notifications_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*GetNotificationsListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_budgeting_service_reporting_and_notifications_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*GetNotificationsListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_budgeting_service_reporting_and_notifications_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*Notification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_budgeting_service_reporting_and_notifications_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*SetBudgetAlertThresholdsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_budgeting_service_reporting_and_notifications_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*SetBudgetAlertThresholdsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_budgeting_service_reporting_and_notifications_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteNotificationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_budgeting_service_reporting_and_notifications_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteNotificationResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_budgeting_service_reporting_and_notifications_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateNotificationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_budgeting_service_reporting_and_notifications_proto_msgTypes[31].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateNotificationResp); i {
 			case 0:
 				return &v.state
@@ -1845,7 +3108,7 @@ func file_budgeting_service_reporting_and_notifications_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_budgeting_service_reporting_and_notifications_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
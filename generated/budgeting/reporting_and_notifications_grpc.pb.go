@@ -19,15 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	ReportingNotificationService_GetSepending_FullMethodName         = "/reporting_notification.ReportingNotificationService/GetSepending"
-	ReportingNotificationService_GetIncome_FullMethodName            = "/reporting_notification.ReportingNotificationService/GetIncome"
-	ReportingNotificationService_GetBudgetPerformance_FullMethodName = "/reporting_notification.ReportingNotificationService/GetBudgetPerformance"
-	ReportingNotificationService_GoalProgress_FullMethodName         = "/reporting_notification.ReportingNotificationService/GoalProgress"
-	ReportingNotificationService_SendNotification_FullMethodName     = "/reporting_notification.ReportingNotificationService/SendNotification"
-	ReportingNotificationService_GetNotificationList_FullMethodName  = "/reporting_notification.ReportingNotificationService/GetNotificationList"
-	ReportingNotificationService_GetNotification_FullMethodName      = "/reporting_notification.ReportingNotificationService/GetNotification"
-	ReportingNotificationService_UpdateNotification_FullMethodName   = "/reporting_notification.ReportingNotificationService/UpdateNotification"
-	ReportingNotificationService_DeleteNotification_FullMethodName   = "/reporting_notification.ReportingNotificationService/DeleteNotification"
+	ReportingNotificationService_GetSepending_FullMethodName             = "/reporting_notification.ReportingNotificationService/GetSepending"
+	ReportingNotificationService_GetIncome_FullMethodName                = "/reporting_notification.ReportingNotificationService/GetIncome"
+	ReportingNotificationService_GetSpendingByTag_FullMethodName         = "/reporting_notification.ReportingNotificationService/GetSpendingByTag"
+	ReportingNotificationService_GetSpendingByCategory_FullMethodName    = "/reporting_notification.ReportingNotificationService/GetSpendingByCategory"
+	ReportingNotificationService_GetBudgetPerformance_FullMethodName     = "/reporting_notification.ReportingNotificationService/GetBudgetPerformance"
+	ReportingNotificationService_GoalProgress_FullMethodName             = "/reporting_notification.ReportingNotificationService/GoalProgress"
+	ReportingNotificationService_ForecastGoal_FullMethodName             = "/reporting_notification.ReportingNotificationService/ForecastGoal"
+	ReportingNotificationService_SendNotification_FullMethodName         = "/reporting_notification.ReportingNotificationService/SendNotification"
+	ReportingNotificationService_GetNotificationList_FullMethodName      = "/reporting_notification.ReportingNotificationService/GetNotificationList"
+	ReportingNotificationService_GetNotification_FullMethodName          = "/reporting_notification.ReportingNotificationService/GetNotification"
+	ReportingNotificationService_UpdateNotification_FullMethodName       = "/reporting_notification.ReportingNotificationService/UpdateNotification"
+	ReportingNotificationService_DeleteNotification_FullMethodName       = "/reporting_notification.ReportingNotificationService/DeleteNotification"
+	ReportingNotificationService_SetBudgetAlertThresholds_FullMethodName = "/reporting_notification.ReportingNotificationService/SetBudgetAlertThresholds"
 )
 
 // ReportingNotificationServiceClient is the client API for ReportingNotificationService service.
@@ -36,14 +40,19 @@ const (
 type ReportingNotificationServiceClient interface {
 	GetSepending(ctx context.Context, in *GetSependingReq, opts ...grpc.CallOption) (*GetSependingResp, error)
 	GetIncome(ctx context.Context, in *GetIncomeReportReq, opts ...grpc.CallOption) (*GetIncomeReportResp, error)
+	GetSpendingByTag(ctx context.Context, in *GetSpendingByTagReq, opts ...grpc.CallOption) (*GetSpendingByTagResp, error)
+	GetSpendingByCategory(ctx context.Context, in *GetSpendingByCategoryReq, opts ...grpc.CallOption) (*GetSpendingByCategoryResp, error)
 	GetBudgetPerformance(ctx context.Context, in *GetBudgetPerformanceReq, opts ...grpc.CallOption) (*GetBudgetPerformanceResp, error)
 	GoalProgress(ctx context.Context, in *GetGoalProgressReq, opts ...grpc.CallOption) (*GetGoalProgressResp, error)
+	ForecastGoal(ctx context.Context, in *ForecastGoalReq, opts ...grpc.CallOption) (*ForecastGoalResp, error)
 	// Notification
 	SendNotification(ctx context.Context, in *SendNotificationReq, opts ...grpc.CallOption) (*SendNotificationResp, error)
 	GetNotificationList(ctx context.Context, in *GetNotificationsListReq, opts ...grpc.CallOption) (*GetNotificationsListResp, error)
 	GetNotification(ctx context.Context, in *GetNotificationReq, opts ...grpc.CallOption) (*GetNotificationResp, error)
 	UpdateNotification(ctx context.Context, in *UpdateNotificationReq, opts ...grpc.CallOption) (*UpdateNotificationResp, error)
 	DeleteNotification(ctx context.Context, in *DeleteNotificationReq, opts ...grpc.CallOption) (*DeleteNotificationResp, error)
+	// Byudjet ogohlantirishlari
+	SetBudgetAlertThresholds(ctx context.Context, in *SetBudgetAlertThresholdsReq, opts ...grpc.CallOption) (*SetBudgetAlertThresholdsResp, error)
 }
 
 type reportingNotificationServiceClient struct {
@@ -74,6 +83,26 @@ func (c *reportingNotificationServiceClient) GetIncome(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *reportingNotificationServiceClient) GetSpendingByTag(ctx context.Context, in *GetSpendingByTagReq, opts ...grpc.CallOption) (*GetSpendingByTagResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSpendingByTagResp)
+	err := c.cc.Invoke(ctx, ReportingNotificationService_GetSpendingByTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportingNotificationServiceClient) GetSpendingByCategory(ctx context.Context, in *GetSpendingByCategoryReq, opts ...grpc.CallOption) (*GetSpendingByCategoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSpendingByCategoryResp)
+	err := c.cc.Invoke(ctx, ReportingNotificationService_GetSpendingByCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reportingNotificationServiceClient) GetBudgetPerformance(ctx context.Context, in *GetBudgetPerformanceReq, opts ...grpc.CallOption) (*GetBudgetPerformanceResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetBudgetPerformanceResp)
@@ -94,6 +123,16 @@ func (c *reportingNotificationServiceClient) GoalProgress(ctx context.Context, i
 	return out, nil
 }
 
+func (c *reportingNotificationServiceClient) ForecastGoal(ctx context.Context, in *ForecastGoalReq, opts ...grpc.CallOption) (*ForecastGoalResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForecastGoalResp)
+	err := c.cc.Invoke(ctx, ReportingNotificationService_ForecastGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reportingNotificationServiceClient) SendNotification(ctx context.Context, in *SendNotificationReq, opts ...grpc.CallOption) (*SendNotificationResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SendNotificationResp)
@@ -144,20 +183,35 @@ func (c *reportingNotificationServiceClient) DeleteNotification(ctx context.Cont
 	return out, nil
 }
 
+func (c *reportingNotificationServiceClient) SetBudgetAlertThresholds(ctx context.Context, in *SetBudgetAlertThresholdsReq, opts ...grpc.CallOption) (*SetBudgetAlertThresholdsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBudgetAlertThresholdsResp)
+	err := c.cc.Invoke(ctx, ReportingNotificationService_SetBudgetAlertThresholds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReportingNotificationServiceServer is the server API for ReportingNotificationService service.
 // All implementations must embed UnimplementedReportingNotificationServiceServer
 // for forward compatibility
 type ReportingNotificationServiceServer interface {
 	GetSepending(context.Context, *GetSependingReq) (*GetSependingResp, error)
 	GetIncome(context.Context, *GetIncomeReportReq) (*GetIncomeReportResp, error)
+	GetSpendingByTag(context.Context, *GetSpendingByTagReq) (*GetSpendingByTagResp, error)
+	GetSpendingByCategory(context.Context, *GetSpendingByCategoryReq) (*GetSpendingByCategoryResp, error)
 	GetBudgetPerformance(context.Context, *GetBudgetPerformanceReq) (*GetBudgetPerformanceResp, error)
 	GoalProgress(context.Context, *GetGoalProgressReq) (*GetGoalProgressResp, error)
+	ForecastGoal(context.Context, *ForecastGoalReq) (*ForecastGoalResp, error)
 	// Notification
 	SendNotification(context.Context, *SendNotificationReq) (*SendNotificationResp, error)
 	GetNotificationList(context.Context, *GetNotificationsListReq) (*GetNotificationsListResp, error)
 	GetNotification(context.Context, *GetNotificationReq) (*GetNotificationResp, error)
 	UpdateNotification(context.Context, *UpdateNotificationReq) (*UpdateNotificationResp, error)
 	DeleteNotification(context.Context, *DeleteNotificationReq) (*DeleteNotificationResp, error)
+	// Byudjet ogohlantirishlari
+	SetBudgetAlertThresholds(context.Context, *SetBudgetAlertThresholdsReq) (*SetBudgetAlertThresholdsResp, error)
 	mustEmbedUnimplementedReportingNotificationServiceServer()
 }
 
@@ -171,12 +225,21 @@ func (UnimplementedReportingNotificationServiceServer) GetSepending(context.Cont
 func (UnimplementedReportingNotificationServiceServer) GetIncome(context.Context, *GetIncomeReportReq) (*GetIncomeReportResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIncome not implemented")
 }
+func (UnimplementedReportingNotificationServiceServer) GetSpendingByTag(context.Context, *GetSpendingByTagReq) (*GetSpendingByTagResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpendingByTag not implemented")
+}
+func (UnimplementedReportingNotificationServiceServer) GetSpendingByCategory(context.Context, *GetSpendingByCategoryReq) (*GetSpendingByCategoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpendingByCategory not implemented")
+}
 func (UnimplementedReportingNotificationServiceServer) GetBudgetPerformance(context.Context, *GetBudgetPerformanceReq) (*GetBudgetPerformanceResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetPerformance not implemented")
 }
 func (UnimplementedReportingNotificationServiceServer) GoalProgress(context.Context, *GetGoalProgressReq) (*GetGoalProgressResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GoalProgress not implemented")
 }
+func (UnimplementedReportingNotificationServiceServer) ForecastGoal(context.Context, *ForecastGoalReq) (*ForecastGoalResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForecastGoal not implemented")
+}
 func (UnimplementedReportingNotificationServiceServer) SendNotification(context.Context, *SendNotificationReq) (*SendNotificationResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendNotification not implemented")
 }
@@ -192,6 +255,9 @@ func (UnimplementedReportingNotificationServiceServer) UpdateNotification(contex
 func (UnimplementedReportingNotificationServiceServer) DeleteNotification(context.Context, *DeleteNotificationReq) (*DeleteNotificationResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotification not implemented")
 }
+func (UnimplementedReportingNotificationServiceServer) SetBudgetAlertThresholds(context.Context, *SetBudgetAlertThresholdsReq) (*SetBudgetAlertThresholdsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBudgetAlertThresholds not implemented")
+}
 func (UnimplementedReportingNotificationServiceServer) mustEmbedUnimplementedReportingNotificationServiceServer() {
 }
 
@@ -242,6 +308,42 @@ func _ReportingNotificationService_GetIncome_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReportingNotificationService_GetSpendingByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendingByTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingNotificationServiceServer).GetSpendingByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportingNotificationService_GetSpendingByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingNotificationServiceServer).GetSpendingByTag(ctx, req.(*GetSpendingByTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportingNotificationService_GetSpendingByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendingByCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingNotificationServiceServer).GetSpendingByCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportingNotificationService_GetSpendingByCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingNotificationServiceServer).GetSpendingByCategory(ctx, req.(*GetSpendingByCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ReportingNotificationService_GetBudgetPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBudgetPerformanceReq)
 	if err := dec(in); err != nil {
@@ -278,6 +380,24 @@ func _ReportingNotificationService_GoalProgress_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReportingNotificationService_ForecastGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForecastGoalReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingNotificationServiceServer).ForecastGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportingNotificationService_ForecastGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingNotificationServiceServer).ForecastGoal(ctx, req.(*ForecastGoalReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ReportingNotificationService_SendNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendNotificationReq)
 	if err := dec(in); err != nil {
@@ -368,6 +488,24 @@ func _ReportingNotificationService_DeleteNotification_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReportingNotificationService_SetBudgetAlertThresholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBudgetAlertThresholdsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingNotificationServiceServer).SetBudgetAlertThresholds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportingNotificationService_SetBudgetAlertThresholds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingNotificationServiceServer).SetBudgetAlertThresholds(ctx, req.(*SetBudgetAlertThresholdsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReportingNotificationService_ServiceDesc is the grpc.ServiceDesc for ReportingNotificationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -383,6 +521,14 @@ var ReportingNotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetIncome",
 			Handler:    _ReportingNotificationService_GetIncome_Handler,
 		},
+		{
+			MethodName: "GetSpendingByTag",
+			Handler:    _ReportingNotificationService_GetSpendingByTag_Handler,
+		},
+		{
+			MethodName: "GetSpendingByCategory",
+			Handler:    _ReportingNotificationService_GetSpendingByCategory_Handler,
+		},
 		{
 			MethodName: "GetBudgetPerformance",
 			Handler:    _ReportingNotificationService_GetBudgetPerformance_Handler,
@@ -391,6 +537,10 @@ var ReportingNotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GoalProgress",
 			Handler:    _ReportingNotificationService_GoalProgress_Handler,
 		},
+		{
+			MethodName: "ForecastGoal",
+			Handler:    _ReportingNotificationService_ForecastGoal_Handler,
+		},
 		{
 			MethodName: "SendNotification",
 			Handler:    _ReportingNotificationService_SendNotification_Handler,
@@ -411,6 +561,10 @@ var ReportingNotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteNotification",
 			Handler:    _ReportingNotificationService_DeleteNotification_Handler,
 		},
+		{
+			MethodName: "SetBudgetAlertThresholds",
+			Handler:    _ReportingNotificationService_SetBudgetAlertThresholds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "budgeting_service/reporting_and_notifications.proto",
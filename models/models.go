@@ -3,55 +3,143 @@ package models
 import "time"
 
 type GetAccount struct {
-	ID       string  `bson:"_id"`
-	UserId   string  `bson:"user_id"`
-	Name     string  `bson:"name"`
-	Type     string  `bson:"type"`
-	Balance  float64 `bson:"balance"`
-	Currency string  `bson:"currency"`
+	ID       string `bson:"_id"`
+	UserId   string `bson:"user_id"`
+	Name     string `bson:"name"`
+	Type     string `bson:"type"`
+	Balance  int64  `bson:"balance"`
+	Currency string `bson:"currency"`
 }
 
 type GetTransaction struct {
-	Id          string    `bson:"_id"`
-	AccountId   string    `bson:"account_id"`
-	UserId      string    `bson:"user_id"`
-	CategoryId  string    `bson:"category_id"`
-	Type        string    `bson:"type"`
-	Amount      float64   `bson:"amount"`
-	Description string    `bson:"description"`
-	Date        time.Time `bson:"date"`
+	Id          string       `bson:"_id"`
+	AccountId   string       `bson:"account_id"`
+	UserId      string       `bson:"user_id"`
+	CategoryId  string       `bson:"category_id"`
+	Type        string       `bson:"type"`
+	Amount      int64        `bson:"amount"`
+	Description string       `bson:"description"`
+	Date        time.Time    `bson:"date"`
+	TransferId  string       `bson:"transfer_id,omitempty"`
+	Tags        []string     `bson:"tags,omitempty"`
+	Notes       string       `bson:"notes,omitempty"`
+	Attachments []Attachment `bson:"attachments,omitempty"`
+	Splits      []Split      `bson:"splits,omitempty"`
+
+	IdempotencyKey string `bson:"idempotency_key,omitempty"`
+	Counterparty   string `bson:"counterparty,omitempty"`
+	// Kategoriya avtomatik qoida bo'yicha berilgan bo'lsa
+	CategoryRuleId string `bson:"category_rule_id,omitempty"`
+}
+
+type Split struct {
+	CategoryId string `bson:"category_id"`
+	Amount     int64  `bson:"amount"`
+	Memo       string `bson:"memo,omitempty"`
+}
+
+type Attachment struct {
+	ID          string    `bson:"_id"`
+	FileName    string    `bson:"file_name"`
+	ContentType string    `bson:"content_type"`
+	Size        int64     `bson:"size"`
+	Checksum    string    `bson:"checksum"`
+	StorageKey  string    `bson:"storage_key"`
+	CreatedAt   time.Time `bson:"created_at"`
+}
+
+// SuspectedDuplicate keyin yaratilgan tranzaksiya avvalgisining dublikati bo'lishi mumkinligi haqida belgi.
+type SuspectedDuplicate struct {
+	ID            string     `bson:"_id"`
+	AccountId     string     `bson:"account_id"`
+	TransactionId string     `bson:"transaction_id"`
+	DuplicateOf   string     `bson:"duplicate_of"`
+	Score         float64    `bson:"score"`
+	Status        string     `bson:"status"`
+	CreatedAt     time.Time  `bson:"created_at"`
+	ResolvedAt    *time.Time `bson:"resolved_at,omitempty"`
 }
 
 type GetCategory struct {
-	ID     string `bson:"_id"`
-	UserId string `bson:"user_id"`
-	Name   string `bson:"name"`
-	Type   string `bson:"type"`
+	ID        string    `bson:"_id"`
+	UserId    string    `bson:"user_id"`
+	Name      string    `bson:"name"`
+	Type      string    `bson:"type"`
+	ParentId  string    `bson:"parent_id,omitempty"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// CategoryRule tranzaksiyaga kategoriya va teglarni avtomatik beruvchi qoida.
+type CategoryRule struct {
+	ID                   string    `bson:"_id"`
+	UserId               string    `bson:"user_id"`
+	Name                 string    `bson:"name"`
+	Priority             int32     `bson:"priority"`
+	Disabled             bool      `bson:"disabled"`
+	DescriptionContains  string    `bson:"description_contains,omitempty"`
+	DescriptionRegex     string    `bson:"description_regex,omitempty"`
+	CounterpartyContains string    `bson:"counterparty_contains,omitempty"`
+	AccountId            string    `bson:"account_id,omitempty"`
+	MinAmount            int64     `bson:"min_amount,omitempty"`
+	MaxAmount            int64     `bson:"max_amount,omitempty"`
+	Type                 string    `bson:"type,omitempty"`
+	CategoryId           string    `bson:"category_id,omitempty"`
+	Tags                 []string  `bson:"tags,omitempty"`
+	CreatedAt            time.Time `bson:"created_at"`
+	UpdatedAt            time.Time `bson:"updated_at"`
+}
+
+// CategoryModel foydalanuvchi tranzaksiyalari bo'yicha o'qitilgan kategoriya
+// taklif modeli (naive Bayes sanoqlari). Kalit kategoriya ID si.
+type CategoryModel struct {
+	UserId     string                      `bson:"_id"`
+	Docs       int64                       `bson:"docs"`
+	Categories map[string]CategoryFeatures `bson:"categories"`
+	UpdatedAt  time.Time                   `bson:"updated_at"`
+}
+
+type CategoryFeatures struct {
+	Docs     int64            `bson:"docs"`
+	Tokens   int64            `bson:"tokens"`
+	Features map[string]int64 `bson:"features"`
 }
 
 type GetBudget struct {
 	ID         string    `bson:"_id"`
 	UserId     string    `bson:"user_id"`
 	CategoryId string    `bson:"category_id"`
-	Amount     float64   `bson:"amount"`
+	Amount     int64     `bson:"amount"`
+	BaseAmount int64     `bson:"base_amount"`
 	Period     string    `bson:"period"`
 	StartDate  time.Time `bson:"start_date"`
 	EndDate    time.Time `bson:"end_date"`
+	// AnchorDate zanjirdagi birinchi davr boshlanishi; eski byudjetlarda bo'sh
+	AnchorDate       time.Time `bson:"anchor_date,omitempty"`
+	AutoRenew        bool      `bson:"auto_renew"`
+	CarryOver        string    `bson:"carry_over"`
+	CarriedAmount    int64     `bson:"carried_amount"`
+	PreviousBudgetId string    `bson:"previous_budget_id"`
+	NextBudgetId     string    `bson:"next_budget_id"`
+	AccountId        string    `bson:"account_id,omitempty"`
+	CreatedAt        time.Time `bson:"created_at"`
 }
 
 type GetGoal struct {
-	ID            string    `bson:"_id"`
-	UserId        string    `bson:"user_id"`
-	Name          string    `bson:"name"`
-	TargetAmount  float64   `bson:"target_amount"`
-	CurrentAmount float64   `bson:"current_amount"`
-	Deadline      time.Time `bson:"deadline"`
-	Status        string    `bson:"status"`
+	ID               string    `bson:"_id"`
+	UserId           string    `bson:"user_id"`
+	Name             string    `bson:"name"`
+	TargetAmount     int64     `bson:"target_amount"`
+	CurrentAmount    int64     `bson:"current_amount"`
+	Deadline         time.Time `bson:"deadline"`
+	Status           string    `bson:"status"`
+	SavingsAccountId string    `bson:"savings_account_id"`
+	AtRisk           bool      `bson:"at_risk"`
+	CreatedAt        time.Time `bson:"created_at"`
 }
 
 type Balance struct {
-	AccountId string  `bson:"account_id"`
-	Balance   float64 `bson:"balance"`
+	AccountId string `bson:"account_id"`
+	Balance   int64  `bson:"balance"`
 }
 
 type Transaction struct {
@@ -59,7 +147,7 @@ type Transaction struct {
 	UserId      string    `bson:"user_id"`
 	CategoryId  string    `bson:"category_id"`
 	Type        string    `bson:"type"`
-	Amount      float64   `bson:"amount"`
+	Amount      int64     `bson:"amount"`
 	Description string    `bson:"description"`
 	Date        time.Time `bson:"date"`
 }
@@ -74,22 +162,115 @@ type Notification struct {
 }
 
 type BudgetPerformance struct {
-	CategoryId string  `bson:"category_id,omitempty"`
-	Target     float64 `bson:"target,omitempty"`
-	Actual     float64 `bson:"actual,omitempty"`
-	Progress   float64 `bson:"progress,omitempty"`
+	CategoryId string `bson:"category_id,omitempty"`
+	Target     int64  `bson:"target,omitempty"`
+	Actual     int64  `bson:"actual,omitempty"`
+	Progress   int64  `bson:"progress,omitempty"`
 }
 
 type GoalProgress struct {
 	Id            string  `bson:"id"`
 	Name          string  `bson:"name"`
-	TargetAmount  float64 `bson:"target_amount"`
-	CurrentAmount float64 `bson:"current_amount"`
+	TargetAmount  int64   `bson:"target_amount"`
+	CurrentAmount int64   `bson:"current_amount"`
 	Progress      float64 `bson:"progress"`
 }
 
 type IncomeReport struct {
-	TotalAmount float64 `bson:"total_amount"`
-	Yearly      bool    `bson:"yearly"`
-	Monthly     bool    `bson:"monthly"`
+	TotalAmount int64 `bson:"total_amount"`
+	Yearly      bool  `bson:"yearly"`
+	Monthly     bool  `bson:"monthly"`
+}
+
+type Posting struct {
+	ID            string    `bson:"_id"`
+	UserId        string    `bson:"user_id"`
+	TransactionId string    `bson:"transaction_id"`
+	Ledger        string    `bson:"ledger"`
+	LedgerId      string    `bson:"ledger_id"`
+	Side          string    `bson:"side"`
+	Amount        int64     `bson:"amount"`
+	Currency      string    `bson:"currency"`
+	Date          time.Time `bson:"date"`
+}
+
+type TrialBalance struct {
+	Currency    string `bson:"_id"`
+	TotalDebit  int64  `bson:"total_debit"`
+	TotalCredit int64  `bson:"total_credit"`
+}
+
+type ExchangeRate struct {
+	FromCurrency string    `bson:"from_currency" json:"from_currency"`
+	ToCurrency   string    `bson:"to_currency" json:"to_currency"`
+	Rate         float64   `bson:"rate" json:"rate"`
+	Date         time.Time `bson:"date" json:"-"`
+}
+
+type ReportTransaction struct {
+	Id         string    `bson:"_id"`
+	AccountId  string    `bson:"account_id"`
+	CategoryId string    `bson:"category_id"`
+	Type       string    `bson:"type"`
+	Amount     int64     `bson:"amount"`
+	Currency   string    `bson:"currency"`
+	Date       time.Time `bson:"date"`
+	Tags       []string  `bson:"tags"`
+}
+
+type RecurringTransaction struct {
+	ID           string     `bson:"_id"`
+	UserId       string     `bson:"user_id"`
+	AccountId    string     `bson:"account_id"`
+	CategoryId   string     `bson:"category_id"`
+	Type         string     `bson:"type"`
+	Amount       int64      `bson:"amount"`
+	Description  string     `bson:"description"`
+	Frequency    string     `bson:"frequency"`
+	Interval     int        `bson:"interval"`
+	StartDate    time.Time  `bson:"start_date"`
+	EndDate      *time.Time `bson:"end_date"`
+	NextRun      time.Time  `bson:"next_run"`
+	Status       string     `bson:"status"`
+	SkippedDates []string   `bson:"skipped_dates"`
+}
+
+type BudgetHistory struct {
+	ID             string    `bson:"_id"`
+	BudgetId       string    `bson:"budget_id"`
+	NextBudgetId   string    `bson:"next_budget_id"`
+	UserId         string    `bson:"user_id"`
+	CategoryId     string    `bson:"category_id"`
+	Period         string    `bson:"period"`
+	StartDate      time.Time `bson:"start_date"`
+	EndDate        time.Time `bson:"end_date"`
+	Target         int64     `bson:"target"`
+	Actual         int64     `bson:"actual"`
+	CarriedForward int64     `bson:"carried_forward"`
+	ClosedAt       time.Time `bson:"closed_at"`
+	AccountId      string    `bson:"account_id,omitempty"`
+}
+
+type GoalContribution struct {
+	ID            string    `bson:"_id"`
+	GoalId        string    `bson:"goal_id"`
+	UserId        string    `bson:"user_id"`
+	Type          string    `bson:"type"`
+	Amount        int64     `bson:"amount"`
+	AccountId     string    `bson:"account_id,omitempty"`
+	TransactionId string    `bson:"transaction_id,omitempty"`
+	TransferId    string    `bson:"transfer_id,omitempty"`
+	Note          string    `bson:"note"`
+	Date          time.Time `bson:"date"`
+}
+
+type AccountMember struct {
+	ID         string     `bson:"_id"`
+	AccountId  string     `bson:"account_id"`
+	UserId     string     `bson:"user_id"`
+	Role       string     `bson:"role"`
+	Status     string     `bson:"status"`
+	InvitedBy  string     `bson:"invited_by"`
+	CreatedAt  time.Time  `bson:"created_at"`
+	AcceptedAt *time.Time `bson:"accepted_at"`
 }
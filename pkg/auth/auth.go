@@ -0,0 +1,79 @@
+// Package auth gRPC so'rovlarini autentifikatsiya qiladi: metadata dagi bearer
+// tokenni tekshiradi va aniqlangan foydalanuvchini context ga joylaydi.
+package auth
+
+import (
+	pbu "budgeting-service/generated/user"
+	"context"
+	"errors"
+	"strings"
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// RoleAdmin global ma'lumotlarni (masalan, valyuta kurslarini) o'zgartira oladigan rol.
+const RoleAdmin = "admin"
+
+// User token orqali aniqlangan foydalanuvchi.
+type User struct {
+	Id    string
+	Email string
+	Role  string
+}
+
+// Verifier tokenni tekshiradi va uning egasini qaytaradi. Yaroqsiz token uchun
+// ErrInvalidToken qaytarilishi kerak.
+type Verifier interface {
+	Verify(ctx context.Context, token string) (User, error)
+}
+
+// VerifierFunc oddiy funksiyani Verifier sifatida ishlatish imkonini beradi
+// (masalan, testlarda auth servisisiz ishlash uchun).
+type VerifierFunc func(ctx context.Context, token string) (User, error)
+
+func (f VerifierFunc) Verify(ctx context.Context, token string) (User, error) {
+	return f(ctx, token)
+}
+
+type authServiceVerifier struct {
+	client pbu.AuthServiceClient
+}
+
+// NewAuthServiceVerifier tokenni auth servisining ValidateToken metodi orqali tekshiradi.
+func NewAuthServiceVerifier(client pbu.AuthServiceClient) Verifier {
+	return &authServiceVerifier{client: client}
+}
+
+func (v *authServiceVerifier) Verify(ctx context.Context, token string) (User, error) {
+	resp, err := v.client.ValidateToken(ctx, &pbu.ValidateTokenReq{Token: token})
+	if err != nil {
+		return User{}, err
+	}
+	if !resp.Valid || resp.UserId == "" {
+		return User{}, ErrInvalidToken
+	}
+	return User{
+		Id:    resp.UserId,
+		Email: resp.Email,
+		Role:  resp.Role,
+	}, nil
+}
+
+type userKey struct{}
+
+// WithUser foydalanuvchini context ga joylaydi.
+func WithUser(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// UserFromContext interceptor joylagan foydalanuvchini qaytaradi.
+func UserFromContext(ctx context.Context) (User, bool) {
+	user, ok := ctx.Value(userKey{}).(User)
+	return user, ok
+}
+
+// IsAdmin context dagi foydalanuvchi admin ekanini bildiradi.
+func IsAdmin(ctx context.Context) bool {
+	user, ok := UserFromContext(ctx)
+	return ok && strings.EqualFold(user.Role, RoleAdmin)
+}
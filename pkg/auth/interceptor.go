@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const userIdField = "user_id"
+
+// UnaryServerInterceptor har bir so'rovni autentifikatsiya qiladi. So'rovdagi
+// user_id token egasiga mos kelmasa, PermissionDenied qaytariladi; bo'sh bo'lsa,
+// token egasining id si bilan to'ldiriladi.
+func UnaryServerInterceptor(verifier Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		user, err := authenticate(ctx, verifier)
+		if err != nil {
+			return nil, err
+		}
+		if err := authorize(user, req); err != nil {
+			return nil, err
+		}
+		return handler(WithUser(ctx, user), req)
+	}
+}
+
+// StreamServerInterceptor stream ochilganda tokenni tekshiradi va har bir
+// kelgan xabardagi user_id ni token egasi bilan solishtiradi.
+func StreamServerInterceptor(verifier Verifier) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		user, err := authenticate(stream.Context(), verifier)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{
+			ServerStream: stream,
+			ctx:          WithUser(stream.Context(), user),
+			user:         user,
+		})
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	user User
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authenticatedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return authorize(s.user, m)
+}
+
+func authenticate(ctx context.Context, verifier Verifier) (User, error) {
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return User{}, err
+	}
+	user, err := verifier.Verify(ctx, token)
+	if err == nil {
+		return user, nil
+	}
+	// Auth servisining o'zi ishlamasa, bu mijozning xatosi emas
+	if st, ok := status.FromError(err); ok && !errors.Is(err, ErrInvalidToken) && st.Code() != codes.Unauthenticated {
+		return User{}, status.Error(codes.Unavailable, "token validation failed: "+st.Message())
+	}
+	return User{}, status.Error(codes.Unauthenticated, ErrInvalidToken.Error())
+}
+
+// bearerToken "authorization: Bearer <token>" metadata sidan tokenni oladi.
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", status.Error(codes.Unauthenticated, "missing authorization token")
+	}
+	scheme, token, found := strings.Cut(values[0], " ")
+	if !found || !strings.EqualFold(scheme, "bearer") || strings.TrimSpace(token) == "" {
+		return "", status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// authorize so'rovning user_id maydonini token egasi bilan solishtiradi.
+func authorize(user User, req interface{}) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	m := msg.ProtoReflect()
+	field := m.Descriptor().Fields().ByName(userIdField)
+	if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+		return nil
+	}
+
+	switch userId := m.Get(field).String(); userId {
+	case user.Id:
+		return nil
+	case "":
+		m.Set(field, protoreflect.ValueOfString(user.Id))
+		return nil
+	default:
+		return status.Error(codes.PermissionDenied, "user_id does not match the authenticated user")
+	}
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var testVerifier = VerifierFunc(func(ctx context.Context, token string) (User, error) {
+	if token != "valid-token" {
+		return User{}, ErrInvalidToken
+	}
+	return User{Id: "user-1", Role: "user"}, nil
+})
+
+func callUnary(ctx context.Context, req interface{}) (User, error) {
+	var user User
+	_, err := UnaryServerInterceptor(testVerifier)(ctx, req, &grpc.UnaryServerInfo{},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			user, _ = UserFromContext(ctx)
+			return nil, nil
+		})
+	return user, err
+}
+
+func withToken(header string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", header))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	user, err := callUnary(withToken("Bearer valid-token"), &pb.GetAccountsListReq{UserId: "user-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "user-1", user.Id)
+
+	_, err = callUnary(context.Background(), &pb.GetAccountsListReq{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = callUnary(withToken("Basic valid-token"), &pb.GetAccountsListReq{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = callUnary(withToken("Bearer wrong-token"), &pb.GetAccountsListReq{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = callUnary(withToken("Bearer valid-token"), &pb.GetAccountsListReq{UserId: "user-2"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestIsAdmin(t *testing.T) {
+	assert.False(t, IsAdmin(context.Background()))
+	assert.False(t, IsAdmin(WithUser(context.Background(), User{Id: "user-1", Role: "user"})))
+	assert.True(t, IsAdmin(WithUser(context.Background(), User{Id: "user-1", Role: "Admin"})))
+}
+
+func TestUnaryServerInterceptorFillsUserId(t *testing.T) {
+	req := &pb.GetAccountsListReq{}
+	_, err := callUnary(withToken("Bearer valid-token"), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "user-1", req.UserId)
+}
+
+func TestAuthServiceUnavailable(t *testing.T) {
+	verifier := VerifierFunc(func(ctx context.Context, token string) (User, error) {
+		return User{}, status.Error(codes.Unavailable, "connection refused")
+	})
+	_, err := authenticate(withToken("Bearer valid-token"), verifier)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+type recvStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	msg *pb.GetAccountsListReq
+}
+
+func (s *recvStream) Context() context.Context { return s.ctx }
+
+func (s *recvStream) RecvMsg(m interface{}) error {
+	m.(*pb.GetAccountsListReq).UserId = s.msg.UserId
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	stream := &recvStream{ctx: withToken("Bearer valid-token"), msg: &pb.GetAccountsListReq{UserId: "user-2"}}
+	err := StreamServerInterceptor(testVerifier)(nil, stream, &grpc.StreamServerInfo{},
+		func(srv interface{}, stream grpc.ServerStream) error {
+			user, ok := UserFromContext(stream.Context())
+			assert.True(t, ok)
+			assert.Equal(t, "user-1", user.Id)
+			return stream.RecvMsg(&pb.GetAccountsListReq{})
+		})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
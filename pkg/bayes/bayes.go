@@ -0,0 +1,147 @@
+// Package bayes tranzaksiya tavsifi va summasi bo'yicha kategoriyani taxmin
+// qiluvchi multinomial naive Bayes modeli. Model faqat sanoqlardan iborat,
+// shuning uchun uni tranzaksiya qo'shilganda yoki kategoriyasi o'zgarganda
+// qayta o'qitmasdan yangilash mumkin.
+package bayes
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Class bitta kategoriya bo'yicha sanoqlar.
+type Class struct {
+	Docs     int64
+	Tokens   int64
+	Features map[string]int64
+}
+
+// Model foydalanuvchining barcha kategoriyalari bo'yicha sanoqlar.
+type Model struct {
+	Docs    int64
+	Classes map[string]*Class
+}
+
+// Suggestion kategoriya va uning ehtimolligi.
+type Suggestion struct {
+	Class string
+	Score float64
+}
+
+func New() *Model {
+	return &Model{Classes: map[string]*Class{}}
+}
+
+// Features matndagi so'zlar va summaning tartibi (xonalar soni). Faqat raqamdan
+// iborat so'zlar (chek, karta raqamlari) va bir harfli so'zlar tashlab yuboriladi.
+// Belgilar harf va raqamlardan iborat, shuning uchun MongoDB kaliti sifatida xavfsiz.
+func Features(text string, amount int64) []string {
+	var features []string
+	seen := map[string]bool{}
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if len([]rune(word)) < 2 || isNumber(word) || seen[word] {
+			continue
+		}
+		seen[word] = true
+		features = append(features, word)
+	}
+	if amount != 0 {
+		if amount < 0 {
+			amount = -amount
+		}
+		features = append(features, "amount_"+strconv.Itoa(len(strconv.FormatInt(amount, 10))))
+	}
+	return features
+}
+
+// Add bitta tranzaksiyani modelga qo'shadi, weight -1 bo'lsa olib tashlaydi.
+func (m *Model) Add(class string, features []string, weight int64) {
+	c, ok := m.Classes[class]
+	if !ok {
+		c = &Class{Features: map[string]int64{}}
+		m.Classes[class] = c
+	}
+	m.Docs += weight
+	c.Docs += weight
+	c.Tokens += weight * int64(len(features))
+	for _, feature := range features {
+		c.Features[feature] += weight
+	}
+}
+
+// Rank kategoriyalarni ehtimollik kamayishi tartibida qaytaradi. allowed nil
+// bo'lmasa, faqat undagi kategoriyalar ko'rib chiqiladi. limit 0 bo'lsa hammasi.
+func (m *Model) Rank(features []string, allowed map[string]bool, limit int) []Suggestion {
+	vocabulary := map[string]bool{}
+	var classes []string
+	for class, c := range m.Classes {
+		if c.Docs <= 0 || (allowed != nil && !allowed[class]) {
+			continue
+		}
+		classes = append(classes, class)
+		for feature, count := range c.Features {
+			if count > 0 {
+				vocabulary[feature] = true
+			}
+		}
+	}
+	if len(classes) == 0 || len(features) == 0 {
+		return nil
+	}
+
+	// Laplace tekislash; log ehtimolliklar softmax bilan normallashtiriladi
+	var docs int64
+	for _, class := range classes {
+		docs += m.Classes[class].Docs
+	}
+	scores := make([]float64, len(classes))
+	best := math.Inf(-1)
+	for i, class := range classes {
+		c := m.Classes[class]
+		score := math.Log(float64(c.Docs+1) / float64(docs+int64(len(classes))))
+		for _, feature := range features {
+			count := c.Features[feature]
+			if count < 0 {
+				count = 0
+			}
+			score += math.Log(float64(count+1) / float64(c.Tokens+int64(len(vocabulary))+1))
+		}
+		scores[i] = score
+		best = math.Max(best, score)
+	}
+	var total float64
+	for i := range scores {
+		scores[i] = math.Exp(scores[i] - best)
+		total += scores[i]
+	}
+
+	suggestions := make([]Suggestion, len(classes))
+	for i, class := range classes {
+		suggestions[i] = Suggestion{Class: class, Score: scores[i] / total}
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].Class < suggestions[j].Class
+	})
+	if limit > 0 && len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+func isNumber(word string) bool {
+	for _, r := range word {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
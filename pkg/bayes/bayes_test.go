@@ -0,0 +1,46 @@
+package bayes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeatures(t *testing.T) {
+	assert.Equal(t, []string{"starbucks", "seattle", "amount_3"}, Features("STARBUCKS #1234 Seattle, starbucks", 550))
+	assert.Equal(t, []string{"oziq", "ovqat", "amount_5"}, Features("Oziq-ovqat  a", -12000))
+	assert.Empty(t, Features("  42 ", 0))
+}
+
+func TestRank(t *testing.T) {
+	model := New()
+	model.Add("coffee", Features("Starbucks Seattle", 550), 1)
+	model.Add("coffee", Features("Costa coffee", 400), 1)
+	model.Add("groceries", Features("Walmart supercenter", 8000), 1)
+	model.Add("groceries", Features("Whole Foods market", 12000), 1)
+
+	suggestions := model.Rank(Features("STARBUCKS 0042", 620), nil, 0)
+	assert.Len(t, suggestions, 2)
+	assert.Equal(t, "coffee", suggestions[0].Class)
+	assert.Greater(t, suggestions[0].Score, 0.5)
+	assert.InDelta(t, 1.0, suggestions[0].Score+suggestions[1].Score, 1e-9)
+
+	suggestions = model.Rank(Features("Starbucks", 620), map[string]bool{"groceries": true}, 1)
+	assert.Len(t, suggestions, 1)
+	assert.Equal(t, "groceries", suggestions[0].Class)
+
+	assert.Empty(t, model.Rank(nil, nil, 0))
+}
+
+func TestAddRemove(t *testing.T) {
+	model := New()
+	model.Add("coffee", Features("Starbucks", 550), 1)
+	model.Add("coffee", Features("Starbucks", 550), -1)
+	model.Add("groceries", Features("Starbucks", 550), 1)
+
+	// Qayta kategoriyalangan tranzaksiya endi faqat yangi kategoriyani o'rgatadi
+	suggestions := model.Rank(Features("Starbucks", 550), nil, 0)
+	assert.Len(t, suggestions, 1)
+	assert.Equal(t, "groceries", suggestions[0].Class)
+	assert.Equal(t, int64(1), model.Docs)
+}
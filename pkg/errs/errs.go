@@ -0,0 +1,125 @@
+// Package errs servisdagi domen xatolari. Har bir xato turga ega va gRPC
+// serverida mos status kodiga aylantiriladi.
+package errs
+
+import (
+	"errors"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Kind xato turi
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindNotFound
+	KindInvalidArgument
+	KindAlreadyExists
+	KindFailedPrecondition
+	KindPermissionDenied
+)
+
+var kindCodes = map[Kind]codes.Code{
+	KindInternal:           codes.Internal,
+	KindNotFound:           codes.NotFound,
+	KindInvalidArgument:    codes.InvalidArgument,
+	KindAlreadyExists:      codes.AlreadyExists,
+	KindFailedPrecondition: codes.FailedPrecondition,
+	KindPermissionDenied:   codes.PermissionDenied,
+}
+
+// FieldViolation so'rovning noto'g'ri maydoni
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error domen xatosi. Mijozga Message ko'rsatiladi, InvalidArgument xatolarida
+// esa Violations errdetails.BadRequest sifatida qo'shiladi.
+type Error struct {
+	Kind       Kind
+	Message    string
+	Violations []FieldViolation
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Code xato turiga mos gRPC kodi
+func (e *Error) Code() codes.Code {
+	return kindCodes[e.Kind]
+}
+
+// GRPCStatus gRPC serveri xatoni shu status bilan qaytaradi.
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(e.Code(), e.Message)
+	if len(e.Violations) == 0 {
+		return st
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+func NotFound(message string) *Error {
+	return &Error{Kind: KindNotFound, Message: message}
+}
+
+func AlreadyExists(message string) *Error {
+	return &Error{Kind: KindAlreadyExists, Message: message}
+}
+
+func FailedPrecondition(message string) *Error {
+	return &Error{Kind: KindFailedPrecondition, Message: message}
+}
+
+func PermissionDenied(message string) *Error {
+	return &Error{Kind: KindPermissionDenied, Message: message}
+}
+
+func Internal(message string) *Error {
+	return &Error{Kind: KindInternal, Message: message}
+}
+
+// InvalidArgument bir yoki bir nechta noto'g'ri maydon haqidagi xato.
+func InvalidArgument(violations ...FieldViolation) *Error {
+	messages := make([]string, 0, len(violations))
+	for _, v := range violations {
+		messages = append(messages, v.Field+": "+v.Description)
+	}
+	return &Error{
+		Kind:       KindInvalidArgument,
+		Message:    strings.Join(messages, "; "),
+		Violations: violations,
+	}
+}
+
+// InvalidField bitta maydon noto'g'ri ekanini bildiradi.
+func InvalidField(field, description string) *Error {
+	return InvalidArgument(FieldViolation{Field: field, Description: description})
+}
+
+// KindOf xato zanjiridagi domen xatosi turini qaytaradi. Domen xatosi
+// bo'lmasa, KindInternal.
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	return KindInternal
+}
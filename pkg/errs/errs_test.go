@@ -0,0 +1,71 @@
+package errs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInvalidArgumentDetails(t *testing.T) {
+	err := InvalidArgument(
+		FieldViolation{Field: "amount", Description: "must be positive"},
+		FieldViolation{Field: "date", Description: "is required"},
+	)
+	assert.Equal(t, "amount: must be positive; date: is required", err.Error())
+
+	st := status.Convert(ToStatus(fmt.Errorf("create: %w", err)))
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	if assert.Len(t, st.Details(), 1) {
+		badRequest := st.Details()[0].(*errdetails.BadRequest)
+		assert.Len(t, badRequest.FieldViolations, 2)
+		assert.Equal(t, "amount", badRequest.FieldViolations[0].Field)
+	}
+}
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{NotFound("goal not found"), codes.NotFound},
+		{AlreadyExists("exists"), codes.AlreadyExists},
+		{FailedPrecondition("completed"), codes.FailedPrecondition},
+		{PermissionDenied("denied"), codes.PermissionDenied},
+		{mongo.ErrNoDocuments, codes.NotFound},
+		{context.DeadlineExceeded, codes.DeadlineExceeded},
+		{status.Error(codes.Unauthenticated, "no token"), codes.Unauthenticated},
+		{errors.New("connection reset"), codes.Internal},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.code, status.Code(ToStatus(tt.err)), tt.err.Error())
+	}
+
+	// Ichki xato tafsilotlari mijozga chiqmaydi
+	assert.Equal(t, "internal error", status.Convert(ToStatus(errors.New("mongo: secret"))).Message())
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, InvalidField("amount", "must be positive")
+		})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
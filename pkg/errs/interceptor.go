@@ -0,0 +1,62 @@
+package errs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor handler qaytargan xatoni gRPC statusiga aylantiradi.
+func UnaryServerInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, toStatus(logger, info.FullMethod, err)
+		}
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor stream handler xatosini gRPC statusiga aylantiradi.
+func StreamServerInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, stream); err != nil {
+			return toStatus(logger, info.FullMethod, err)
+		}
+		return nil
+	}
+}
+
+// ToStatus xatoni gRPC status xatosiga aylantiradi. Domen xatolari o'z kodini
+// oladi, allaqachon status bo'lgan xatolar o'zgarmaydi, noma'lum xatolar esa
+// tafsilotlari oshkor qilinmasdan Internal bo'ladi.
+func ToStatus(err error) error {
+	var domain *Error
+	switch {
+	case errors.As(err, &domain):
+		return domain.GRPCStatus().Err()
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return status.Error(codes.NotFound, "not found")
+	case mongo.IsDuplicateKeyError(err):
+		return status.Error(codes.AlreadyExists, "already exists")
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	}
+	if st, ok := status.FromError(err); ok {
+		return st.Err()
+	}
+	return status.Error(codes.Internal, "internal error")
+}
+
+func toStatus(logger *slog.Logger, method string, err error) error {
+	converted := ToStatus(err)
+	if status.Code(converted) == codes.Internal {
+		logger.Error("Internal error", "method", method, "error", err)
+	}
+	return converted
+}
@@ -0,0 +1,79 @@
+package exchange
+
+import (
+	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
+	"budgeting-service/pkg/money"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const DateLayout = "2006-01-02"
+
+type pair struct {
+	from string
+	to   string
+}
+
+// Table valyuta kurslarini sana bo'yicha saqlaydi va summani
+// berilgan sanada amal qilgan kurs bo'yicha konvertatsiya qiladi.
+type Table struct {
+	rates map[pair][]models.ExchangeRate
+}
+
+func NewTable(rates []models.ExchangeRate) *Table {
+	table := &Table{rates: make(map[pair][]models.ExchangeRate)}
+	for _, rate := range rates {
+		key := pair{from: normalize(rate.FromCurrency), to: normalize(rate.ToCurrency)}
+		table.rates[key] = append(table.rates[key], rate)
+	}
+	for key := range table.rates {
+		sort.Slice(table.rates[key], func(i, j int) bool {
+			return table.rates[key][i].Date.Before(table.rates[key][j].Date)
+		})
+	}
+	return table
+}
+
+// Rate from valyutasidan to valyutasiga date sanasida amal qilgan kursni qaytaradi.
+// To'g'ridan-to'g'ri kurs bo'lmasa, teskari kurs ishlatiladi.
+func (t *Table) Rate(from, to string, date time.Time) (float64, error) {
+	from, to = normalize(from), normalize(to)
+	if from == to {
+		return 1, nil
+	}
+	if rate, ok := t.effective(pair{from: from, to: to}, date); ok {
+		return rate, nil
+	}
+	if rate, ok := t.effective(pair{from: to, to: from}, date); ok && rate != 0 {
+		return 1 / rate, nil
+	}
+	return 0, errs.FailedPrecondition(fmt.Sprintf("exchange rate %s/%s is not available for %s", from, to, date.Format(DateLayout)))
+}
+
+// Convert minor units dagi summani to valyutasiga o'tkazadi.
+func (t *Table) Convert(amount int64, from, to string, date time.Time) (int64, error) {
+	rate, err := t.Rate(from, to, date)
+	if err != nil {
+		return 0, err
+	}
+	return money.Convert(amount, from, to, rate), nil
+}
+
+func (t *Table) effective(key pair, date time.Time) (float64, bool) {
+	rates := t.rates[key]
+	// date dan keyin boshlanadigan birinchi kursni topamiz, undan oldingisi amal qiladi
+	i := sort.Search(len(rates), func(i int) bool {
+		return rates[i].Date.After(date)
+	})
+	if i == 0 {
+		return 0, false
+	}
+	return rates[i-1].Rate, true
+}
+
+func normalize(currency string) string {
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
@@ -0,0 +1,60 @@
+package exchange
+
+import (
+	"budgeting-service/models"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(s string) time.Time {
+	d, _ := time.Parse(DateLayout, s)
+	return d
+}
+
+func TestTableRate(t *testing.T) {
+	table := NewTable([]models.ExchangeRate{
+		{FromCurrency: "USD", ToCurrency: "UZS", Rate: 12000, Date: date("2024-01-01")},
+		{FromCurrency: "USD", ToCurrency: "UZS", Rate: 12500, Date: date("2024-02-01")},
+	})
+
+	rate, err := table.Rate("USD", "UZS", date("2024-01-15"))
+	assert.NoError(t, err)
+	assert.Equal(t, 12000.0, rate)
+
+	rate, err = table.Rate("usd", "uzs", date("2024-03-01"))
+	assert.NoError(t, err)
+	assert.Equal(t, 12500.0, rate)
+
+	amount, err := table.Convert(2500000, "UZS", "USD", date("2024-02-01"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(200), amount)
+
+	_, err = table.Rate("USD", "UZS", date("2023-12-31"))
+	assert.Error(t, err)
+
+	rate, err = table.Rate("EUR", "EUR", date("2023-12-31"))
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, rate)
+}
+
+func TestParseCSV(t *testing.T) {
+	rates, err := ParseCSV(strings.NewReader("from_currency,to_currency,rate,date\nusd,uzs,12650,2024-01-01\nEUR,USD,1.09,2024-01-01\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(rates))
+	assert.Equal(t, "USD", rates[0].FromCurrency)
+	assert.Equal(t, 12650.0, rates[0].Rate)
+
+	_, err = ParseCSV(strings.NewReader("USD,UZS,-1,2024-01-01\n"))
+	assert.Error(t, err)
+}
+
+func TestParseJSON(t *testing.T) {
+	rates, err := ParseJSON(strings.NewReader(`[{"from_currency": "EUR", "to_currency": "USD", "rate": 1.09, "date": "2024-01-01"}]`))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rates))
+	assert.Equal(t, date("2024-01-01"), rates[0].Date)
+	assert.Equal(t, 1.09, rates[0].Rate)
+}
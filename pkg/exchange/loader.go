@@ -0,0 +1,98 @@
+package exchange
+
+import (
+	"budgeting-service/models"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LoadFile kurslarni CSV yoki JSON fayldan o'qiydi.
+//
+// CSV: from_currency,to_currency,rate,date (sarlavha qatori bilan)
+// JSON: [{"from_currency": "USD", "to_currency": "UZS", "rate": 12650, "date": "2024-01-01"}]
+func LoadFile(path string) ([]models.ExchangeRate, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return ParseCSV(file)
+	case ".json":
+		return ParseJSON(file)
+	default:
+		return nil, fmt.Errorf("unsupported exchange rates file: %s", path)
+	}
+}
+
+func ParseCSV(r io.Reader) ([]models.ExchangeRate, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var rates []models.ExchangeRate
+	for i, record := range records {
+		if i == 0 && strings.EqualFold(strings.TrimSpace(record[0]), "from_currency") {
+			continue
+		}
+		if len(record) != 4 {
+			return nil, fmt.Errorf("line %d: expected 4 columns, got %d", i+1, len(record))
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		if rate <= 0 {
+			return nil, fmt.Errorf("line %d: rate must be positive", i+1)
+		}
+		date, err := time.Parse(DateLayout, strings.TrimSpace(record[3]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		rates = append(rates, models.ExchangeRate{
+			FromCurrency: normalize(record[0]),
+			ToCurrency:   normalize(record[1]),
+			Rate:         rate,
+			Date:         date,
+		})
+	}
+	return rates, nil
+}
+
+func ParseJSON(r io.Reader) ([]models.ExchangeRate, error) {
+	var items []struct {
+		models.ExchangeRate
+		Date string `json:"date"`
+	}
+	if err := json.NewDecoder(r).Decode(&items); err != nil {
+		return nil, err
+	}
+
+	var rates []models.ExchangeRate
+	for i, item := range items {
+		date, err := time.Parse(DateLayout, item.Date)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		if item.Rate <= 0 {
+			return nil, fmt.Errorf("item %d: rate must be positive", i)
+		}
+		rates = append(rates, models.ExchangeRate{
+			FromCurrency: normalize(item.FromCurrency),
+			ToCurrency:   normalize(item.ToCurrency),
+			Rate:         item.Rate,
+			Date:         date,
+		})
+	}
+	return rates, nil
+}
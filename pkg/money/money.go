@@ -0,0 +1,105 @@
+// Package money pul summalarini valyutaning eng kichik birligida (minor units,
+// masalan cent yoki tiyin) int64 ko'rinishida ifodalaydi. Barcha hisob-kitoblar
+// butun sonlarda bajariladi, shuning uchun float64 dagi yaxlitlash xatolari yo'q.
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const defaultExponent = 2
+
+// ISO 4217 bo'yicha kasr qismi 2 xonadan farq qiladigan valyutalar
+var exponents = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+// Exponent valyutaning kasr xonalari sonini qaytaradi.
+func Exponent(currency string) int {
+	if exp, ok := exponents[strings.ToUpper(strings.TrimSpace(currency))]; ok {
+		return exp
+	}
+	return defaultExponent
+}
+
+// FromFloat o'nli summani minor units ga o'tkazadi va valyuta aniqligigacha
+// yaxlitlaydi (half away from zero). NaN, cheksizlik va int64 ga sig'maydigan
+// summalar uchun xato qaytadi.
+func FromFloat(value float64, currency string) (int64, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, fmt.Errorf("invalid amount %v", value)
+	}
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(value, 'f', -1, 64))
+	if !ok {
+		return 0, fmt.Errorf("invalid amount %v", value)
+	}
+	r.Mul(r, pow10(Exponent(currency)))
+	if new(big.Rat).Abs(r).Cmp(new(big.Rat).SetInt64(math.MaxInt64)) > 0 {
+		return 0, fmt.Errorf("amount %v is out of range", value)
+	}
+	return round(r), nil
+}
+
+// Parse "-1234.56" ko'rinishidagi o'nli matnni float64 ga o'tkazmasdan minor units ga
+// aylantiradi va valyuta aniqligigacha yaxlitlaydi (half away from zero).
+func Parse(value, currency string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if strings.ContainsAny(value, "eE/") {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+	r, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+	r.Mul(r, pow10(Exponent(currency)))
+	if new(big.Rat).Abs(r).Cmp(new(big.Rat).SetInt64(math.MaxInt64)) > 0 {
+		return 0, fmt.Errorf("amount %q is out of range", value)
+	}
+	return round(r), nil
+}
+
+// ToFloat minor units ni o'nli songa o'tkazadi (faqat ko'rsatish uchun).
+func ToFloat(minor int64, currency string) float64 {
+	f, _ := new(big.Rat).SetFrac(big.NewInt(minor), pow10(Exponent(currency)).Num()).Float64()
+	return f
+}
+
+// Format summani valyuta aniqligida matnga o'tkazadi, masalan "12.34 USD".
+func Format(minor int64, currency string) string {
+	return strconv.FormatFloat(ToFloat(minor, currency), 'f', Exponent(currency), 64) + " " + strings.ToUpper(currency)
+}
+
+// Convert from valyutasidagi summani rate kursi bo'yicha to valyutasiga o'tkazadi.
+// Natija to valyutasining aniqligigacha yaxlitlanadi.
+func Convert(minor int64, from, to string, rate float64) int64 {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(rate, 'f', -1, 64))
+	r.Mul(r, new(big.Rat).SetInt64(minor))
+	r.Mul(r, pow10(Exponent(to)))
+	r.Quo(r, pow10(Exponent(from)))
+	return round(r)
+}
+
+func pow10(n int) *big.Rat {
+	return new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil))
+}
+
+func round(r *big.Rat) int64 {
+	num, den := new(big.Int).Set(r.Num()), r.Denom()
+	negative := num.Sign() < 0
+	num.Abs(num)
+
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Mul(rem, big.NewInt(2)).Cmp(den) >= 0 {
+		quo.Add(quo, big.NewInt(1))
+	}
+	if negative {
+		quo.Neg(quo)
+	}
+	return quo.Int64()
+}
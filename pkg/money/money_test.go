@@ -0,0 +1,70 @@
+package money
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromFloat(t *testing.T) {
+	for _, tc := range []struct {
+		value    float64
+		currency string
+		want     int64
+	}{
+		{1.005, "USD", 101},
+		{-1.005, "USD", -101},
+		{0.1 + 0.2, "USD", 30},
+		{1234.5, "JPY", 1235},
+		{1.2345, "KWD", 1235},
+	} {
+		amount, err := FromFloat(tc.value, tc.currency)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.want, amount, tc.value)
+	}
+
+	for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1), 1e30} {
+		_, err := FromFloat(value, "USD")
+		assert.Error(t, err, value)
+	}
+}
+
+func TestParse(t *testing.T) {
+	amount, err := Parse("1234.56", "USD")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(123456), amount)
+
+	amount, err = Parse(" -0.005 ", "USD")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-1), amount)
+
+	amount, err = Parse("1500", "JPY")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1500), amount)
+
+	for _, value := range []string{"", "12,50", "1e3", "1/2", "abc", "99999999999999999999"} {
+		_, err := Parse(value, "USD")
+		assert.Error(t, err, value)
+	}
+}
+
+func TestToFloat(t *testing.T) {
+	assert.Equal(t, 12.34, ToFloat(1234, "USD"))
+	assert.Equal(t, 1234.0, ToFloat(1234, "JPY"))
+}
+
+func TestFormat(t *testing.T) {
+	assert.Equal(t, "12.30 USD", Format(1230, "usd"))
+	assert.Equal(t, "-5 JPY", Format(-5, "JPY"))
+	assert.Equal(t, "1.235 KWD", Format(1235, "KWD"))
+}
+
+func TestConvert(t *testing.T) {
+	// 10.00 USD -> 126500.00 UZS
+	assert.Equal(t, int64(12650000), Convert(1000, "USD", "UZS", 12650))
+	// 1000 JPY -> 6.67 USD
+	assert.Equal(t, int64(667), Convert(1000, "JPY", "USD", 0.006667))
+	// 25000.00 UZS -> 2.00 USD
+	assert.Equal(t, int64(200), Convert(2500000, "UZS", "USD", 1.0/12500))
+}
@@ -0,0 +1,95 @@
+// Package recurrence takrorlanuvchi tranzaksiyalar jadvalini hisoblaydi
+// (RRULE ga o'xshash: FREQ + INTERVAL + boshlanish/tugash sanasi).
+package recurrence
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	Daily   = "DAILY"
+	Weekly  = "WEEKLY"
+	Monthly = "MONTHLY"
+	Yearly  = "YEARLY"
+)
+
+type Schedule struct {
+	Frequency string
+	Interval  int
+	Start     time.Time
+	// End nol qiymatda bo'lsa, jadval cheksiz davom etadi
+	End time.Time
+}
+
+func (s Schedule) Validate() error {
+	switch strings.ToUpper(s.Frequency) {
+	case Daily, Weekly, Monthly, Yearly:
+	default:
+		return fmt.Errorf("invalid frequency: %s", s.Frequency)
+	}
+	if s.Interval < 0 {
+		return fmt.Errorf("interval must be positive")
+	}
+	if s.Start.IsZero() {
+		return fmt.Errorf("start date is required")
+	}
+	if !s.End.IsZero() && s.End.Before(s.Start) {
+		return fmt.Errorf("end date must be after start date")
+	}
+	return nil
+}
+
+// Occurrence jadvalning n-chi (0 dan boshlab) sanasini qaytaradi. Oylik va yillik
+// jadvallarda oy oxiridan oshib ketgan kun oyning oxirgi kuniga tushiriladi
+// (masalan, 31-yanvar -> 29-fevral), keyingi oylarda esa asl kun saqlanadi.
+func (s Schedule) Occurrence(n int) time.Time {
+	step := n * s.interval()
+	switch strings.ToUpper(s.Frequency) {
+	case Daily:
+		return s.Start.AddDate(0, 0, step)
+	case Weekly:
+		return s.Start.AddDate(0, 0, 7*step)
+	case Monthly:
+		return addMonths(s.Start, step)
+	case Yearly:
+		return addMonths(s.Start, 12*step)
+	}
+	return s.Start
+}
+
+// Next after dan keyingi birinchi sanani qaytaradi. Jadval tugagan bo'lsa false.
+func (s Schedule) Next(after time.Time) (time.Time, bool) {
+	for n := 0; ; n++ {
+		occurrence := s.Occurrence(n)
+		if !s.End.IsZero() && occurrence.After(s.End) {
+			return time.Time{}, false
+		}
+		if occurrence.After(after) {
+			return occurrence, true
+		}
+	}
+}
+
+// First from sanasidan boshlab (from ham kiradi) birinchi sanani qaytaradi.
+func (s Schedule) First(from time.Time) (time.Time, bool) {
+	return s.Next(from.Add(-time.Nanosecond))
+}
+
+func (s Schedule) interval() int {
+	if s.Interval <= 0 {
+		return 1
+	}
+	return s.Interval
+}
+
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	first := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return first.AddDate(0, 0, day-1)
+}
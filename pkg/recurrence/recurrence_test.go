@@ -0,0 +1,44 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(s string) time.Time {
+	d, _ := time.Parse("2006-01-02", s)
+	return d
+}
+
+func TestMonthlyOccurrence(t *testing.T) {
+	schedule := Schedule{Frequency: Monthly, Interval: 1, Start: date("2024-01-31")}
+
+	assert.Equal(t, date("2024-01-31"), schedule.Occurrence(0))
+	assert.Equal(t, date("2024-02-29"), schedule.Occurrence(1))
+	assert.Equal(t, date("2024-03-31"), schedule.Occurrence(2))
+	assert.Equal(t, date("2024-04-30"), schedule.Occurrence(3))
+}
+
+func TestNext(t *testing.T) {
+	schedule := Schedule{Frequency: Weekly, Interval: 2, Start: date("2024-01-01"), End: date("2024-02-01")}
+
+	next, ok := schedule.Next(date("2024-01-01"))
+	assert.True(t, ok)
+	assert.Equal(t, date("2024-01-15"), next)
+
+	first, ok := schedule.First(date("2024-01-01"))
+	assert.True(t, ok)
+	assert.Equal(t, date("2024-01-01"), first)
+
+	_, ok = schedule.Next(date("2024-01-29"))
+	assert.False(t, ok)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Schedule{Frequency: "daily", Start: date("2024-01-01")}.Validate())
+	assert.Error(t, Schedule{Frequency: "HOURLY", Start: date("2024-01-01")}.Validate())
+	assert.Error(t, Schedule{Frequency: Daily}.Validate())
+	assert.Error(t, Schedule{Frequency: Daily, Start: date("2024-02-01"), End: date("2024-01-01")}.Validate())
+}
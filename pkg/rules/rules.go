@@ -0,0 +1,137 @@
+// Package rules tranzaksiyalarni foydalanuvchi qoidalari bo'yicha avtomatik
+// kategoriyalaydi. Qoidalar priority o'sish tartibida tekshiriladi va birinchi
+// mos kelgani qo'llanadi.
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Rule bitta qoida. Bo'sh (nol) shartlar tekshirilmaydi.
+type Rule struct {
+	Id       string
+	Priority int32
+	Disabled bool
+
+	DescriptionContains  string
+	DescriptionRegex     string
+	CounterpartyContains string
+	AccountId            string
+	MinAmount            int64
+	MaxAmount            int64
+	Type                 string
+
+	CategoryId string
+	Tags       []string
+}
+
+// Transaction qoidalar tekshiradigan tranzaksiya maydonlari.
+type Transaction struct {
+	AccountId    string
+	Description  string
+	Counterparty string
+	Amount       int64
+	Type         string
+}
+
+// HasConditions qoidada kamida bitta shart borligini bildiradi: shartsiz qoida
+// barcha tranzaksiyalarga mos kelib qolardi.
+func (r Rule) HasConditions() bool {
+	return r.DescriptionContains != "" || r.DescriptionRegex != "" || r.CounterpartyContains != "" ||
+		r.AccountId != "" || r.MinAmount != 0 || r.MaxAmount != 0 || r.Type != ""
+}
+
+// Compile description_regex ni tekshiradi. Regex katta-kichik harfni farqlamaydi.
+func (r Rule) Compile() (*regexp.Regexp, error) {
+	if r.DescriptionRegex == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile("(?i)" + r.DescriptionRegex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid description regex: %w", err)
+	}
+	return re, nil
+}
+
+// Matches qoidaning barcha shartlari tranzaksiyaga mos kelsa true.
+// Noto'g'ri regexli qoida hech narsaga mos kelmaydi. Regex har safar
+// kompilyatsiya qilinadi; ko'p tranzaksiya uchun Matcher ishlatiladi.
+func (r Rule) Matches(t Transaction) bool {
+	re, err := r.Compile()
+	return err == nil && r.matches(t, re)
+}
+
+// matches re - r.Compile() natijasi.
+func (r Rule) matches(t Transaction, re *regexp.Regexp) bool {
+	if r.Disabled || !r.HasConditions() {
+		return false
+	}
+	if r.AccountId != "" && r.AccountId != t.AccountId {
+		return false
+	}
+	if r.Type != "" && !strings.EqualFold(r.Type, t.Type) {
+		return false
+	}
+	if r.MinAmount != 0 && t.Amount < r.MinAmount {
+		return false
+	}
+	if r.MaxAmount != 0 && t.Amount > r.MaxAmount {
+		return false
+	}
+	if !containsFold(t.Description, r.DescriptionContains) || !containsFold(t.Counterparty, r.CounterpartyContains) {
+		return false
+	}
+	if re != nil && !re.MatchString(t.Description) {
+		return false
+	}
+	return true
+}
+
+// Matcher priority bo'yicha tartiblangan, regexlari bir marta kompilyatsiya
+// qilingan qoidalar. Butun tarixni qayta kategoriyalashda ishlatiladi.
+type Matcher struct {
+	rules   []Rule
+	regexps []*regexp.Regexp
+}
+
+// NewMatcher qoidalarni priority bo'yicha tartiblaydi (bir xil priorityda berilgan
+// tartib saqlanadi). Noto'g'ri regexli qoidalar tashlab yuboriladi.
+func NewMatcher(rules []Rule) *Matcher {
+	sorted := append([]Rule(nil), rules...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority < sorted[j].Priority
+	})
+	m := &Matcher{}
+	for _, rule := range sorted {
+		re, err := rule.Compile()
+		if err != nil {
+			continue
+		}
+		m.rules = append(m.rules, rule)
+		m.regexps = append(m.regexps, re)
+	}
+	return m
+}
+
+// First birinchi mos kelgan qoidani qaytaradi.
+func (m *Matcher) First(t Transaction) (Rule, bool) {
+	for i, rule := range m.rules {
+		if rule.matches(t, m.regexps[i]) {
+			return rule, true
+		}
+	}
+	return Rule{}, false
+}
+
+// First priority bo'yicha birinchi mos kelgan qoidani qaytaradi. Bir xil
+// priorityda berilgan tartib saqlanadi.
+func First(rules []Rule, t Transaction) (Rule, bool) {
+	return NewMatcher(rules).First(t)
+}
+
+func containsFold(value, substr string) bool {
+	return substr == "" || strings.Contains(strings.ToLower(value), strings.ToLower(substr))
+}
@@ -0,0 +1,55 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatches(t *testing.T) {
+	coffee := Transaction{AccountId: "card", Description: "STARBUCKS #1234 Seattle", Counterparty: "Starbucks Corp", Amount: 550, Type: "expense"}
+
+	assert.True(t, Rule{DescriptionContains: "starbucks"}.Matches(coffee))
+	assert.True(t, Rule{DescriptionRegex: `^starbucks #\d+`}.Matches(coffee))
+	assert.True(t, Rule{CounterpartyContains: "corp", AccountId: "card", Type: "EXPENSE"}.Matches(coffee))
+	assert.True(t, Rule{MinAmount: 500, MaxAmount: 550}.Matches(coffee))
+
+	assert.False(t, Rule{}.Matches(coffee))
+	assert.False(t, Rule{DescriptionContains: "starbucks", Disabled: true}.Matches(coffee))
+	assert.False(t, Rule{DescriptionContains: "starbucks", AccountId: "cash"}.Matches(coffee))
+	assert.False(t, Rule{DescriptionContains: "starbucks", Type: "income"}.Matches(coffee))
+	assert.False(t, Rule{MinAmount: 600}.Matches(coffee))
+	assert.False(t, Rule{MaxAmount: 500}.Matches(coffee))
+	assert.False(t, Rule{CounterpartyContains: "costa"}.Matches(coffee))
+	assert.False(t, Rule{DescriptionRegex: "("}.Matches(coffee))
+}
+
+func TestCompile(t *testing.T) {
+	re, err := Rule{}.Compile()
+	assert.NoError(t, err)
+	assert.Nil(t, re)
+
+	_, err = Rule{DescriptionRegex: "[a-"}.Compile()
+	assert.Error(t, err)
+}
+
+func TestFirst(t *testing.T) {
+	rules := []Rule{
+		{Id: "generic", Priority: 10, DescriptionContains: "uber"},
+		{Id: "eats", Priority: 1, DescriptionContains: "uber eats"},
+		{Id: "disabled", Priority: 0, DescriptionContains: "uber", Disabled: true},
+		{Id: "also-generic", Priority: 10, DescriptionContains: "u"},
+		{Id: "invalid", Priority: 0, DescriptionRegex: "("},
+	}
+
+	rule, ok := First(rules, Transaction{Description: "UBER EATS order"})
+	assert.True(t, ok)
+	assert.Equal(t, "eats", rule.Id)
+
+	rule, ok = First(rules, Transaction{Description: "Uber trip"})
+	assert.True(t, ok)
+	assert.Equal(t, "generic", rule.Id)
+
+	_, ok = First(rules, Transaction{Description: "Lyft"})
+	assert.False(t, ok)
+}
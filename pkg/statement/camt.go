@@ -0,0 +1,167 @@
+package statement
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// camtEntry CAMT.053 dagi <Ntry> elementi. Teglar namespace siz berilgan, shuning
+// uchun camt.053.001.02 dan .08 gacha bo'lgan versiyalar bir xil o'qiladi.
+type camtEntry struct {
+	Amount struct {
+		Currency string `xml:"Ccy,attr"`
+		Value    string `xml:",chardata"`
+	} `xml:"Amt"`
+	Indicator string `xml:"CdtDbtInd"`
+	Status    struct {
+		Value string `xml:",chardata"`
+		Code  string `xml:"Cd"`
+	} `xml:"Sts"`
+	BookingDate camtDate `xml:"BookgDt"`
+	ValueDate   camtDate `xml:"ValDt"`
+	ServicerRef string   `xml:"AcctSvcrRef"`
+	EntryRef    string   `xml:"NtryRef"`
+	Details     []struct {
+		EndToEndId   string   `xml:"Refs>EndToEndId"`
+		Unstructured []string `xml:"RmtInf>Ustrd"`
+		Additional   string   `xml:"AddtlTxInf"`
+		// Yangi versiyalarda nom Pty ichida beriladi
+		Creditor      string `xml:"RltdPties>Cdtr>Nm"`
+		CreditorParty string `xml:"RltdPties>Cdtr>Pty>Nm"`
+		Debtor        string `xml:"RltdPties>Dbtr>Nm"`
+		DebtorParty   string `xml:"RltdPties>Dbtr>Pty>Nm"`
+	} `xml:"NtryDtls>TxDtls"`
+	AdditionalInfo string `xml:"AddtlNtryInf"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+func (d camtDate) parse() (time.Time, bool) {
+	if d.Date != "" {
+		t, err := time.Parse("2006-01-02", strings.TrimSpace(d.Date))
+		return t, err == nil
+	}
+	if d.DateTime != "" {
+		value := strings.TrimSpace(d.DateTime)
+		if len(value) >= 19 {
+			t, err := time.Parse("2006-01-02T15:04:05", value[:19])
+			return t, err == nil
+		}
+	}
+	return time.Time{}, false
+}
+
+// ParseCAMT053 ISO 20022 camt.053 (Bank To Customer Statement) ko'chirmasini o'qiydi.
+// Faqat bajarilgan (BOOK) yozuvlar import qilinadi, qolganlari xato bilan qaytadi.
+func ParseCAMT053(r io.Reader, currency string) ([]Row, error) {
+	decoder := xml.NewDecoder(r)
+	var rows []Row
+	document := false
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Local == "BkToCstmrStmt" {
+			document = true
+		}
+		if start.Name.Local != "Ntry" {
+			continue
+		}
+
+		line, _ := decoder.InputPos()
+		var entry camtEntry
+		if err := decoder.DecodeElement(&entry, &start); err != nil {
+			return nil, err
+		}
+		rows = append(rows, camtRow(entry, currency, line))
+	}
+	if !document {
+		return nil, fmt.Errorf("not a camt.053 document")
+	}
+	return rows, nil
+}
+
+func camtRow(entry camtEntry, currency string, line int) Row {
+	row := Row{
+		Line:        line,
+		Currency:    strings.TrimSpace(entry.Amount.Currency),
+		Reference:   firstNonEmpty(entry.ServicerRef, entry.EntryRef),
+		Description: strings.TrimSpace(entry.AdditionalInfo),
+	}
+	credit := strings.EqualFold(strings.TrimSpace(entry.Indicator), "CRDT")
+	for _, details := range entry.Details {
+		if row.Reference == "" {
+			row.Reference = strings.TrimSpace(details.EndToEndId)
+		}
+		// Kirimda qarshi tomon to'lovchi, chiqimda oluvchi
+		if row.Counterparty == "" && credit {
+			row.Counterparty = firstNonEmpty(details.Debtor, details.DebtorParty)
+		} else if row.Counterparty == "" {
+			row.Counterparty = firstNonEmpty(details.Creditor, details.CreditorParty)
+		}
+		if text := strings.TrimSpace(strings.Join(details.Unstructured, " ")); text != "" {
+			row.Description = text
+			break
+		}
+		if text := strings.TrimSpace(details.Additional); text != "" && row.Description == "" {
+			row.Description = text
+		}
+	}
+
+	status := strings.ToUpper(firstNonEmpty(entry.Status.Code, entry.Status.Value))
+	if status != "" && status != "BOOK" {
+		row.Err = fmt.Errorf("entry status %s is not booked", status)
+		return row
+	}
+
+	date, ok := entry.BookingDate.parse()
+	if !ok {
+		date, ok = entry.ValueDate.parse()
+	}
+	if !ok {
+		row.Err = fmt.Errorf("entry has no valid booking date")
+		return row
+	}
+	row.Date = date
+
+	if row.Currency != "" {
+		currency = row.Currency
+	}
+	amount, err := parseAmount(entry.Amount.Value, ".", currency)
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	switch strings.ToUpper(strings.TrimSpace(entry.Indicator)) {
+	case "CRDT":
+		row.Amount = amount
+	case "DBIT":
+		row.Amount = -amount
+	default:
+		row.Err = fmt.Errorf("invalid credit/debit indicator %q", entry.Indicator)
+	}
+	return row
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
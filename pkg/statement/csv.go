@@ -0,0 +1,197 @@
+package statement
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const defaultDateLayout = "2006-01-02"
+
+// CSVMapping CSV ustunlarini yozuv maydonlariga bog'laydi. Ustun sarlavhadagi
+// nomi (HasHeader bo'lsa, katta-kichik harf farqlanmaydi) yoki 1 dan boshlangan
+// tartib raqami bilan beriladi. Amount o'rniga alohida Debit (chiqim) va Credit
+// (kirim) ustunlari berilishi mumkin.
+type CSVMapping struct {
+	Date             string
+	Amount           string
+	Debit            string
+	Credit           string
+	Description      string
+	Counterparty     string
+	Reference        string
+	DateLayout       string
+	Delimiter        string
+	HasHeader        bool
+	DecimalSeparator string
+}
+
+// csvColumns ustunlarning 0 dan boshlangan indekslari, berilmagani -1.
+type csvColumns struct {
+	date, amount, debit, credit, description, counterparty, reference int
+}
+
+// ParseCSV CSV ko'chirmani mapping bo'yicha o'qiydi.
+func ParseCSV(r io.Reader, mapping CSVMapping, currency string) ([]Row, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if mapping.Delimiter != "" {
+		delimiter, size := utf8.DecodeRuneInString(mapping.Delimiter)
+		if size != len(mapping.Delimiter) {
+			return nil, fmt.Errorf("delimiter must be a single character")
+		}
+		reader.Comma = delimiter
+	}
+	layout := mapping.DateLayout
+	if layout == "" {
+		layout = defaultDateLayout
+	}
+
+	var header []string
+	if mapping.HasHeader {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		header = record
+	}
+	columns, err := resolveColumns(mapping, header)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []Row
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			rows = append(rows, Row{Line: parseErr.Line, Err: parseErr.Err})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if isBlank(record) {
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, csvRow(line, record, columns, layout, mapping.DecimalSeparator, currency))
+	}
+	return rows, nil
+}
+
+func csvRow(line int, record []string, columns csvColumns, layout, decimalSeparator, currency string) Row {
+	field := func(index int) string {
+		if index < 0 || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+	row := Row{
+		Line:         line,
+		Description:  field(columns.description),
+		Counterparty: field(columns.counterparty),
+		Reference:    field(columns.reference),
+	}
+
+	date, err := time.Parse(layout, field(columns.date))
+	if err != nil {
+		row.Err = fmt.Errorf("invalid date %q", field(columns.date))
+		return row
+	}
+	row.Date = date
+
+	if columns.amount >= 0 {
+		row.Amount, err = parseAmount(field(columns.amount), decimalSeparator, currency)
+		if err != nil {
+			row.Err = err
+		}
+		return row
+	}
+
+	// Chiqim va kirim alohida ustunlarda: ikkalasidan biri to'ldirilgan bo'ladi
+	debit, credit := field(columns.debit), field(columns.credit)
+	switch {
+	case debit != "" && credit == "":
+		row.Amount, err = parseAmount(strings.TrimPrefix(debit, "-"), decimalSeparator, currency)
+		row.Amount = -row.Amount
+	case credit != "" && debit == "":
+		row.Amount, err = parseAmount(credit, decimalSeparator, currency)
+	default:
+		err = fmt.Errorf("exactly one of debit and credit must be set")
+	}
+	row.Err = err
+	return row
+}
+
+func resolveColumns(mapping CSVMapping, header []string) (csvColumns, error) {
+	var columns csvColumns
+	var err error
+	resolve := func(name string, required bool) int {
+		index, e := columnIndex(name, header)
+		if e != nil && err == nil {
+			err = e
+		}
+		if index < 0 && required && err == nil {
+			err = fmt.Errorf("date column is required")
+		}
+		return index
+	}
+
+	columns.date = resolve(mapping.Date, true)
+	columns.amount = resolve(mapping.Amount, false)
+	columns.debit = resolve(mapping.Debit, false)
+	columns.credit = resolve(mapping.Credit, false)
+	columns.description = resolve(mapping.Description, false)
+	columns.counterparty = resolve(mapping.Counterparty, false)
+	columns.reference = resolve(mapping.Reference, false)
+	if err != nil {
+		return columns, err
+	}
+	if columns.amount < 0 && (columns.debit < 0 || columns.credit < 0) {
+		return columns, fmt.Errorf("amount column or both debit and credit columns are required")
+	}
+	return columns, nil
+}
+
+// columnIndex ustun nomi yoki raqamini 0 dan boshlangan indeksga o'tkazadi.
+// Berilmagan ustun uchun -1.
+func columnIndex(name string, header []string) (int, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return -1, nil
+	}
+	if n, err := strconv.Atoi(name); err == nil {
+		if n < 1 {
+			return -1, fmt.Errorf("column number must be at least 1")
+		}
+		return n - 1, nil
+	}
+	for i, column := range header {
+		if strings.EqualFold(strings.TrimSpace(column), name) {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("column %q not found", name)
+}
+
+func isBlank(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,143 @@
+package statement
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ParseOFX OFX va QFX (Quicken) ko'chirmalarini o'qiydi. OFX 1.x SGML ko'rinishida
+// yopuvchi teglar bo'lmasligi mumkin, shuning uchun fayl XML sifatida emas,
+// teglar bo'yicha oddiy skaner bilan o'qiladi.
+func ParseOFX(r io.Reader, currency string) ([]Row, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	content := string(data)
+	// Teglar faqat ASCII harflar bo'yicha solishtiriladi: strings.ToUpper
+	// UTF-8 bo'lmagan baytlarni (CHARSET:1252) uzunroq belgiga almashtiradi
+	// va upper dagi indekslar content ga to'g'ri kelmay qoladi.
+	upper := asciiUpper(content)
+	if !strings.Contains(upper, "<OFX>") {
+		return nil, fmt.Errorf("not an OFX document")
+	}
+	if curdef := ofxValue(content, upper, "CURDEF"); curdef != "" {
+		currency = curdef
+	}
+
+	var rows []Row
+	// line - offset gacha bo'lgan qator raqami; har safar faqat yangi qism sanaladi
+	offset, line := 0, 1
+	for {
+		start := strings.Index(upper[offset:], "<STMTTRN>")
+		if start < 0 {
+			break
+		}
+		start += offset
+		end := strings.Index(upper[start:], "</STMTTRN>")
+		if end < 0 {
+			end = len(upper)
+		} else {
+			end += start
+		}
+		line += strings.Count(content[offset:start], "\n")
+		rows = append(rows, ofxRow(content[start:end], upper[start:end], currency, line))
+		next := end
+		if next < len(upper) {
+			next += len("</STMTTRN>")
+		}
+		line += strings.Count(content[start:next], "\n")
+		offset = next
+	}
+	return rows, nil
+}
+
+func ofxRow(block, upper, currency string, line int) Row {
+	row := Row{
+		Line:      line,
+		Currency:  currency,
+		Reference: ofxValue(block, upper, "FITID"),
+	}
+
+	// NAME odatda qisqartirilgan bo'ladi, MEMO esa qo'shimcha ma'lumot
+	name, memo := ofxValue(block, upper, "NAME"), ofxValue(block, upper, "MEMO")
+	row.Counterparty = name
+	switch {
+	case name == "":
+		row.Description = memo
+	case memo == "" || memo == name:
+		row.Description = name
+	default:
+		row.Description = name + " " + memo
+	}
+
+	date, err := parseOFXDate(ofxValue(block, upper, "DTPOSTED"))
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	row.Date = date
+
+	row.Amount, err = parseAmount(ofxValue(block, upper, "TRNAMT"), ".", currency)
+	if err != nil {
+		row.Err = err
+	}
+	return row
+}
+
+// ofxValue <TAG> dan keyingi qiymatni qaytaradi: keyingi teg yoki qator oxirigacha.
+func ofxValue(block, upper, tag string) string {
+	start := strings.Index(upper, "<"+tag+">")
+	if start < 0 {
+		return ""
+	}
+	value := block[start+len(tag)+2:]
+	if end := strings.IndexAny(value, "<\r\n"); end >= 0 {
+		value = value[:end]
+	}
+	return html.UnescapeString(strings.TrimSpace(decodeLatin1(value)))
+}
+
+// asciiUpper faqat a-z harflarini katta qiladi, baytlar soni o'zgarmaydi.
+func asciiUpper(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if c >= 'a' && c <= 'z' {
+			b[i] = c - 'a' + 'A'
+		}
+	}
+	return string(b)
+}
+
+// decodeLatin1 UTF-8 bo'lmagan qiymatni Latin-1 deb o'qiydi (OFX 1.x
+// ko'chirmalari ko'pincha CHARSET:1252 bo'ladi).
+func decodeLatin1(value string) string {
+	if utf8.ValidString(value) {
+		return value
+	}
+	runes := make([]rune, len(value))
+	for i := 0; i < len(value); i++ {
+		runes[i] = rune(value[i])
+	}
+	return string(runes)
+}
+
+// parseOFXDate "20240131", "20240131120000" yoki "20240131120000.000[-5:EST]"
+// ko'rinishidagi sanani o'qiydi. Vaqt mintaqasi e'tiborga olinmaydi.
+func parseOFXDate(value string) (time.Time, error) {
+	digits := 0
+	for digits < len(value) && value[digits] >= '0' && value[digits] <= '9' {
+		digits++
+	}
+	switch {
+	case digits >= 14:
+		return time.Parse("20060102150405", value[:14])
+	case digits >= 8:
+		return time.Parse("20060102", value[:8])
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
@@ -0,0 +1,81 @@
+// Package statement bank ko'chirmalarini (CSV, OFX/QFX, ISO 20022 CAMT.053)
+// tranzaksiya qatorlariga o'giradi. Summalar hisob valyutasining minor units
+// ko'rinishida qaytariladi: manfiy summa - chiqim, musbat - kirim.
+package statement
+
+import (
+	"budgeting-service/pkg/money"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Ko'chirma formatlari
+const (
+	FormatCSV     = "csv"
+	FormatOFX     = "ofx"
+	FormatQFX     = "qfx"
+	FormatCAMT053 = "camt053"
+)
+
+var Formats = []string{FormatCSV, FormatOFX, FormatQFX, FormatCAMT053}
+
+// Row ko'chirmadagi bitta yozuv. Yozuvni o'qib bo'lmasa, Err to'ldiriladi va
+// qolgan yozuvlar o'qilishda davom etadi.
+type Row struct {
+	Line         int
+	Date         time.Time
+	Amount       int64
+	Currency     string // faylda ko'rsatilgan bo'lsa
+	Description  string
+	Counterparty string // to'lovchi yoki oluvchi nomi
+	Reference    string // bankdagi yagona identifikator (FITID, AcctSvcrRef)
+	Err          error
+}
+
+// Options tahlil sozlamalari. Currency summalar aniqligini belgilaydi.
+type Options struct {
+	Currency string
+	CSV      CSVMapping
+}
+
+// Parse format bo'yicha ko'chirmani o'qiydi. Fayl umuman o'qilmasa xato qaytadi,
+// alohida yozuvlardagi xatolar esa Row.Err da bo'ladi.
+func Parse(format string, r io.Reader, opts Options) ([]Row, error) {
+	switch strings.ToLower(format) {
+	case FormatCSV:
+		return ParseCSV(r, opts.CSV, opts.Currency)
+	case FormatOFX, FormatQFX:
+		return ParseOFX(r, opts.Currency)
+	case FormatCAMT053:
+		return ParseCAMT053(r, opts.Currency)
+	}
+	return nil, fmt.Errorf("unknown statement format %q", format)
+}
+
+// parseAmount bankdagi summa matnini minor units ga o'tkazadi. Ming ajratgichlar,
+// bo'shliqlar va qavs ichidagi manfiy summalar "(12.50)" qabul qilinadi.
+func parseAmount(value, decimalSeparator, currency string) (int64, error) {
+	value = strings.NewReplacer(" ", "", " ", "", "'", "").Replace(strings.TrimSpace(value))
+	negative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative = true
+		value = value[1 : len(value)-1]
+	}
+	if decimalSeparator == "," {
+		value = strings.ReplaceAll(value, ".", "")
+		value = strings.ReplaceAll(value, ",", ".")
+	} else {
+		value = strings.ReplaceAll(value, ",", "")
+	}
+
+	amount, err := money.Parse(value, currency)
+	if err != nil {
+		return 0, err
+	}
+	if negative {
+		amount = -amount
+	}
+	return amount, nil
+}
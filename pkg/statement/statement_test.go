@@ -0,0 +1,196 @@
+package statement
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAmount(t *testing.T) {
+	amount, err := parseAmount("1,234.56", ".", "USD")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(123456), amount)
+
+	amount, err = parseAmount("1.234,56", ",", "EUR")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(123456), amount)
+
+	amount, err = parseAmount("(12.50)", ".", "USD")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-1250), amount)
+
+	_, err = parseAmount("abc", ".", "USD")
+	assert.Error(t, err)
+}
+
+func TestParseCSV(t *testing.T) {
+	data := "Date;Amount;Payee;Id\n" +
+		"31.01.2024;-1 234,50;Market;A1\n" +
+		"\n" +
+		"01.02.2024;2000;Salary;A2\n" +
+		"bad;10;Broken;A3\n"
+	rows, err := ParseCSV(strings.NewReader(data), CSVMapping{
+		Date:             "date",
+		Amount:           "amount",
+		Description:      "payee",
+		Counterparty:     "payee",
+		Reference:        "4",
+		DateLayout:       "02.01.2006",
+		Delimiter:        ";",
+		HasHeader:        true,
+		DecimalSeparator: ",",
+	}, "EUR")
+	assert.NoError(t, err)
+	assert.Len(t, rows, 3)
+
+	assert.NoError(t, rows[0].Err)
+	assert.Equal(t, 2, rows[0].Line)
+	assert.Equal(t, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), rows[0].Date)
+	assert.Equal(t, int64(-123450), rows[0].Amount)
+	assert.Equal(t, "Market", rows[0].Description)
+	assert.Equal(t, "Market", rows[0].Counterparty)
+	assert.Equal(t, "A1", rows[0].Reference)
+
+	assert.Equal(t, int64(200000), rows[1].Amount)
+	assert.Equal(t, 5, rows[2].Line)
+	assert.Error(t, rows[2].Err)
+}
+
+func TestParseCSVDebitCredit(t *testing.T) {
+	data := "2024-01-05,12.30,,Coffee\n2024-01-06,,100,Refund\n2024-01-07,1,2,Both\n"
+	rows, err := ParseCSV(strings.NewReader(data), CSVMapping{Date: "1", Debit: "2", Credit: "3", Description: "4"}, "USD")
+	assert.NoError(t, err)
+	assert.Len(t, rows, 3)
+	assert.Equal(t, int64(-1230), rows[0].Amount)
+	assert.Equal(t, int64(10000), rows[1].Amount)
+	assert.Error(t, rows[2].Err)
+
+	_, err = ParseCSV(strings.NewReader(data), CSVMapping{Date: "1", Debit: "2"}, "USD")
+	assert.Error(t, err)
+	_, err = ParseCSV(strings.NewReader(data), CSVMapping{Date: "missing", Amount: "2", HasHeader: true}, "USD")
+	assert.Error(t, err)
+}
+
+func TestParseOFX(t *testing.T) {
+	data := `OFXHEADER:100
+DATA:OFXSGML
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>USD
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20240131120000.000[-5:EST]
+<TRNAMT>-42.10
+<FITID>2024013101
+<NAME>Tom &amp; Jerry's
+<MEMO>Dinner
+</STMTTRN>
+<STMTTRN><TRNTYPE>CREDIT<DTPOSTED>20240201<TRNAMT>1500.00<FITID>2024020101<NAME>Payroll</NAME></STMTTRN>
+<STMTTRN>
+<DTPOSTED>garbage
+<TRNAMT>1
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`
+	rows, err := Parse(FormatQFX, strings.NewReader(data), Options{})
+	assert.NoError(t, err)
+	assert.Len(t, rows, 3)
+
+	assert.NoError(t, rows[0].Err)
+	assert.Equal(t, 8, rows[0].Line)
+	assert.Equal(t, time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC), rows[0].Date)
+	assert.Equal(t, int64(-4210), rows[0].Amount)
+	assert.Equal(t, "USD", rows[0].Currency)
+	assert.Equal(t, "2024013101", rows[0].Reference)
+	assert.Equal(t, "Tom & Jerry's Dinner", rows[0].Description)
+
+	assert.NoError(t, rows[1].Err)
+	assert.Equal(t, 16, rows[1].Line)
+	assert.Equal(t, int64(150000), rows[1].Amount)
+	assert.Equal(t, "Payroll", rows[1].Description)
+	assert.Equal(t, "Payroll", rows[1].Counterparty)
+
+	assert.Error(t, rows[2].Err)
+	assert.Equal(t, 17, rows[2].Line)
+
+	_, err = ParseOFX(strings.NewReader("date,amount"), "USD")
+	assert.Error(t, err)
+}
+
+func TestParseOFXLatin1(t *testing.T) {
+	// CHARSET:1252 dagi "Café" - \xe9 UTF-8 emas
+	data := "OFXHEADER:100\nCHARSET:1252\n\n<ofx>\n<stmttrn>\n<dtposted>20240131\n<name>Caf\xe9 \xe9\xe9\xe9\xe9\xe9\xe9\xe9\xe9\n<memo>Cr\xe8me br\xfbl\xe9e\n<trnamt>-4.50\n</stmttrn>\n</ofx>"
+	rows, err := ParseOFX(strings.NewReader(data), "EUR")
+	assert.NoError(t, err)
+	assert.Len(t, rows, 1)
+	assert.NoError(t, rows[0].Err)
+	assert.Equal(t, int64(-450), rows[0].Amount)
+	assert.Equal(t, "Café éééééééé", rows[0].Counterparty)
+	assert.Equal(t, "Café éééééééé Crème brûlée", rows[0].Description)
+}
+
+func TestParseCAMT053(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">
+  <BkToCstmrStmt>
+    <Stmt>
+      <Ntry>
+        <Amt Ccy="EUR">25.00</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts>BOOK</Sts>
+        <BookgDt><Dt>2024-03-01</Dt></BookgDt>
+        <AcctSvcrRef>REF-1</AcctSvcrRef>
+        <NtryDtls><TxDtls><RltdPties><Cdtr><Nm>ACME GmbH</Nm></Cdtr></RltdPties><RmtInf><Ustrd>Invoice 42</Ustrd></RmtInf></TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">100.5</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Sts><Cd>BOOK</Cd></Sts>
+        <ValDt><DtTm>2024-03-02T10:15:00+01:00</DtTm></ValDt>
+        <NtryDtls><TxDtls><Refs><EndToEndId>E2E-2</EndToEndId></Refs><RltdPties><Dbtr><Pty><Nm>John Doe</Nm></Pty></Dbtr></RltdPties></TxDtls></NtryDtls>
+        <AddtlNtryInf>Transfer</AddtlNtryInf>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">1.00</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts>PDNG</Sts>
+        <BookgDt><Dt>2024-03-03</Dt></BookgDt>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>`
+	rows, err := Parse(FormatCAMT053, strings.NewReader(data), Options{Currency: "USD"})
+	assert.NoError(t, err)
+	assert.Len(t, rows, 3)
+
+	assert.NoError(t, rows[0].Err)
+	assert.Equal(t, 5, rows[0].Line)
+	assert.Equal(t, int64(-2500), rows[0].Amount)
+	assert.Equal(t, "EUR", rows[0].Currency)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), rows[0].Date)
+	assert.Equal(t, "REF-1", rows[0].Reference)
+	assert.Equal(t, "Invoice 42", rows[0].Description)
+	assert.Equal(t, "ACME GmbH", rows[0].Counterparty)
+
+	assert.NoError(t, rows[1].Err)
+	assert.Equal(t, int64(10050), rows[1].Amount)
+	assert.Equal(t, time.Date(2024, 3, 2, 10, 15, 0, 0, time.UTC), rows[1].Date)
+	assert.Equal(t, "E2E-2", rows[1].Reference)
+	assert.Equal(t, "Transfer", rows[1].Description)
+	assert.Equal(t, "John Doe", rows[1].Counterparty)
+
+	assert.Error(t, rows[2].Err)
+
+	_, err = ParseCAMT053(strings.NewReader("<Document></Document>"), "USD")
+	assert.Error(t, err)
+}
+
+func TestParseUnknownFormat(t *testing.T) {
+	_, err := Parse("xls", strings.NewReader(""), Options{})
+	assert.Error(t, err)
+}
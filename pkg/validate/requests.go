@@ -0,0 +1,499 @@
+package validate
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/pkg/exchange"
+	"budgeting-service/pkg/recurrence"
+	"budgeting-service/pkg/rules"
+	"budgeting-service/pkg/statement"
+	"budgeting-service/storage/mongodb"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	dateTimeLayout = "2006-01-02 15:04:05"
+	dayLayout      = "2006-01-02"
+)
+
+var frequencies = []string{recurrence.Daily, recurrence.Weekly, recurrence.Monthly, recurrence.Yearly}
+
+// Budgeting service so'rovlari
+func init() {
+	Register(func(req *pb.CreateCategoryReq, r *Rules) {
+		r.Required("name", req.Name)
+		r.Required("type", req.Type)
+	})
+	Register(func(req *pb.GetCategoriesReq, r *Rules) {
+		r.Cursor("page", req.Page, req.Limit, req.PageToken)
+	})
+	Register(func(req *pb.SuggestCategoryReq, r *Rules) {
+		if strings.TrimSpace(req.Description) == "" && strings.TrimSpace(req.Counterparty) == "" {
+			r.Add("description", "description or counterparty is required")
+		}
+		r.NonNegative("amount", req.Amount)
+		r.Between("limit", req.Limit, 0, MaxPageSize)
+	})
+	Register(func(req *pb.GetCategoryReq, r *Rules) {
+		r.Required("id", req.Id)
+	})
+	Register(func(req *pb.UpdateCategoryReq, r *Rules) {
+		r.Required("id", req.Id)
+		r.Required("name", req.Name)
+		r.Required("type", req.Type)
+	})
+	Register(func(req *pb.DeleteCategoryReq, r *Rules) {
+		r.Required("id", req.Id)
+		if req.ReplacementCategoryId != "" && req.ReplacementCategoryId == req.Id {
+			r.Add("replacement_category_id", "must be different from id")
+		}
+	})
+	Register(func(req *pb.MoveCategoryReq, r *Rules) {
+		r.Required("id", req.Id)
+		if req.ParentId != "" && req.ParentId == req.Id {
+			r.Add("parent_id", "must be different from id")
+		}
+	})
+	Register(func(req *pb.MergeCategoriesReq, r *Rules) {
+		r.Required("source_id", req.SourceId)
+		r.Required("target_id", req.TargetId)
+		if req.TargetId != "" && req.TargetId == req.SourceId {
+			r.Add("target_id", "must be different from source_id")
+		}
+	})
+
+	Register(func(req *pb.CreateBudgetReq, r *Rules) {
+		r.Positive("amount", req.Amount)
+		budgetPeriod(r, req.Period, req.StartDate, req.EndDate, req.CarryOver)
+	})
+	Register(func(req *pb.GetBudgetsReq, r *Rules) {
+		r.Cursor("page", req.Page, req.Limit, req.PageToken)
+		r.OneOf("period", req.Period, frequencies...)
+		start := r.Date("start_date", time.RFC3339, req.StartDate)
+		end := r.Date("end_date", time.RFC3339, req.EndDate)
+		r.Range("start_date", start, "end_date", end)
+	})
+	Register(func(req *pb.GetBudgetReq, r *Rules) {
+		r.Required("id", req.Id)
+	})
+	Register(func(req *pb.UpdateBudgetReq, r *Rules) {
+		r.Required("id", req.Id)
+		r.Positive("amount", req.Amount)
+		budgetPeriod(r, req.Period, req.StartDate, req.EndDate, req.CarryOver)
+	})
+	Register(func(req *pb.DeleteBudgetReq, r *Rules) {
+		r.Required("id", req.Id)
+	})
+}
+
+// Finance management service so'rovlari
+func init() {
+	Register(func(req *pb.CreateAccountReq, r *Rules) {
+		r.Required("name", req.Name)
+		r.Required("type", req.Type)
+		r.Currency("currency", req.Currency)
+	})
+	Register(func(req *pb.GetAccountsListReq, r *Rules) {
+		r.Page("offset", req.Offset, req.Limit)
+	})
+	Register(func(req *pb.GetAccountReq, r *Rules) {
+		r.Required("id", req.Id)
+	})
+	Register(func(req *pb.UpdateAccountReq, r *Rules) {
+		r.Required("id", req.Id)
+		r.Required("name", req.Name)
+		r.Required("type", req.Type)
+		r.Currency("currency", req.Currency)
+	})
+	Register(func(req *pb.DeleteAccountReq, r *Rules) {
+		r.Required("id", req.Id)
+	})
+
+	Register(func(req *pb.CreateTransactionReq, r *Rules) {
+		r.Required("account_id", req.AccountId)
+		transactionFields(r, req.Type, req.Amount, req.Date)
+		tags(r, req.Tags, false)
+		splits(r, req.Splits, req.Amount, req.CategoryId)
+		if len(req.IdempotencyKey) > MaxIdempotencyKeyLength {
+			r.Add("idempotency_key", fmt.Sprintf("must not exceed %d characters", MaxIdempotencyKeyLength))
+		}
+	})
+	Register(func(req *pb.GetTransactionsListReq, r *Rules) {
+		r.Cursor("page", req.Page, req.Limit, req.PageToken)
+		r.OneOf("type", req.Type, mongodb.TransactionTypeIncome, mongodb.TransactionTypeExpense,
+			mongodb.TransactionTypeTransferIn, mongodb.TransactionTypeTransferOut)
+		from := r.Date("date_from", dateTimeLayout, req.DateFrom)
+		to := r.Date("date_to", dateTimeLayout, req.DateTo)
+		r.Range("date_from", from, "date_to", to)
+		r.NonNegative("min_amount", req.MinAmount)
+		r.NonNegative("max_amount", req.MaxAmount)
+		if req.MaxAmount != 0 && req.MaxAmount < req.MinAmount {
+			r.Add("max_amount", "must not be less than min_amount")
+		}
+		r.OneOf("sort_by", req.SortBy, mongodb.TransactionSortDate, mongodb.TransactionSortAmount)
+		r.OneOf("sort_order", req.SortOrder, mongodb.SortOrderAsc, mongodb.SortOrderDesc)
+	})
+	Register(func(req *pb.GetTransactionReq, r *Rules) {
+		r.Required("id", req.Id)
+	})
+	Register(func(req *pb.UpdateTransactionReq, r *Rules) {
+		r.Required("id", req.Id)
+		transactionFields(r, req.Type, req.Amount, req.Date)
+		splits(r, req.Splits, req.Amount, req.CategoryId)
+	})
+	Register(func(req *pb.DeleteTransactionReq, r *Rules) {
+		r.Required("id", req.Id)
+	})
+	Register(func(req *pb.AddTransactionTagsReq, r *Rules) {
+		r.Required("transaction_id", req.TransactionId)
+		tags(r, req.Tags, true)
+	})
+	Register(func(req *pb.RemoveTransactionTagsReq, r *Rules) {
+		r.Required("transaction_id", req.TransactionId)
+		tags(r, req.Tags, true)
+	})
+	Register(func(req *pb.AddTransactionAttachmentReq, r *Rules) {
+		r.Required("transaction_id", req.TransactionId)
+		r.Required("file_name", req.FileName)
+		r.Required("content_type", req.ContentType)
+		r.Positive("size", req.Size)
+		r.Required("checksum", req.Checksum)
+		r.Required("storage_key", req.StorageKey)
+	})
+	Register(func(req *pb.RemoveTransactionAttachmentReq, r *Rules) {
+		r.Required("transaction_id", req.TransactionId)
+		r.Required("attachment_id", req.AttachmentId)
+	})
+	Register(func(req *pb.CreateTransferReq, r *Rules) {
+		r.Required("from_account_id", req.FromAccountId)
+		r.Required("to_account_id", req.ToAccountId)
+		if req.FromAccountId != "" && req.FromAccountId == req.ToAccountId {
+			r.Add("to_account_id", "must be different from from_account_id")
+		}
+		r.Positive("amount", req.Amount)
+		if req.Rate < 0 {
+			r.Add("rate", "can not be negative")
+		}
+		r.Required("date", req.Date)
+		r.Date("date", dateTimeLayout, req.Date)
+	})
+	Register(func(req *pb.ListSuspectedDuplicatesReq, r *Rules) {
+		r.Between("limit", req.Limit, 0, MaxPageSize)
+	})
+	Register(func(req *pb.MergeDuplicatesReq, r *Rules) {
+		r.Required("id", req.Id)
+		if req.Dismiss && req.KeepTransactionId != "" {
+			r.Add("keep_transaction_id", "can not be combined with dismiss")
+		}
+	})
+	Register(func(req *pb.ImportStatementReq, r *Rules) {
+		// Sozlamalar faqat birinchi xabarda, keyingilarida faqat chunk keladi
+		if req.Format == "" {
+			return
+		}
+		r.Required("account_id", req.AccountId)
+		r.OneOf("format", req.Format, statement.Formats...)
+		if !strings.EqualFold(req.Format, statement.FormatCSV) {
+			return
+		}
+		mapping := req.CsvMapping
+		if mapping == nil {
+			r.Add("csv_mapping", "is required")
+			return
+		}
+		r.Required("csv_mapping.date", mapping.Date)
+		if mapping.Amount == "" && (mapping.Debit == "" || mapping.Credit == "") {
+			r.Add("csv_mapping.amount", "is required unless debit and credit are given")
+		}
+		if utf8.RuneCountInString(mapping.Delimiter) > 1 {
+			r.Add("csv_mapping.delimiter", "must be a single character")
+		}
+		r.OneOf("csv_mapping.decimal_separator", mapping.DecimalSeparator, ".", ",")
+	})
+	Register(func(req *pb.CreateCategoryRuleReq, r *Rules) {
+		categoryRule(r, req.Name, req.Priority, req.Tags, rules.Rule{
+			DescriptionContains:  req.DescriptionContains,
+			DescriptionRegex:     req.DescriptionRegex,
+			CounterpartyContains: req.CounterpartyContains,
+			AccountId:            req.AccountId,
+			MinAmount:            req.MinAmount,
+			MaxAmount:            req.MaxAmount,
+			Type:                 req.Type,
+			CategoryId:           req.CategoryId,
+		})
+	})
+	Register(func(req *pb.GetCategoryRuleReq, r *Rules) {
+		r.Required("id", req.Id)
+	})
+	Register(func(req *pb.UpdateCategoryRuleReq, r *Rules) {
+		// Qoida to'liq almashtiriladi
+		r.Required("id", req.Id)
+		categoryRule(r, req.Name, req.Priority, req.Tags, rules.Rule{
+			DescriptionContains:  req.DescriptionContains,
+			DescriptionRegex:     req.DescriptionRegex,
+			CounterpartyContains: req.CounterpartyContains,
+			AccountId:            req.AccountId,
+			MinAmount:            req.MinAmount,
+			MaxAmount:            req.MaxAmount,
+			Type:                 req.Type,
+			CategoryId:           req.CategoryId,
+		})
+	})
+	Register(func(req *pb.DeleteCategoryRuleReq, r *Rules) {
+		r.Required("id", req.Id)
+	})
+	Register(func(req *pb.TestCategoryRulesReq, r *Rules) {
+		r.NonNegative("amount", req.Amount)
+		r.OneOf("type", req.Type, mongodb.TransactionTypeIncome, mongodb.TransactionTypeExpense)
+	})
+	Register(func(req *pb.ApplyCategoryRulesReq, r *Rules) {
+		from := r.Date("date_from", dateTimeLayout, req.DateFrom)
+		to := r.Date("date_to", dateTimeLayout, req.DateTo)
+		r.Range("date_from", from, "date_to", to)
+	})
+
+	Register(func(req *pb.SetExchangeRatesReq, r *Rules) {
+		if len(req.Rates) == 0 {
+			r.Add("rates", "is required")
+		}
+		for i, rate := range req.Rates {
+			field := fmt.Sprintf("rates[%d].", i)
+			r.Currency(field+"from_currency", rate.FromCurrency)
+			r.Currency(field+"to_currency", rate.ToCurrency)
+			if rate.Rate <= 0 {
+				r.Add(field+"rate", "must be positive")
+			}
+			r.Required(field+"date", rate.Date)
+			r.Date(field+"date", exchange.DateLayout, rate.Date)
+		}
+	})
+	Register(func(req *pb.SetBaseCurrencyReq, r *Rules) {
+		r.Currency("base_currency", req.BaseCurrency)
+	})
+
+	Register(func(req *pb.CreateRecurringTransactionReq, r *Rules) {
+		r.Required("account_id", req.AccountId)
+		r.Required("type", req.Type)
+		r.OneOf("type", req.Type, mongodb.TransactionTypeIncome, mongodb.TransactionTypeExpense)
+		r.Positive("amount", req.Amount)
+		r.Required("frequency", req.Frequency)
+		r.OneOf("frequency", req.Frequency, frequencies...)
+		r.Positive("interval", int64(req.Interval))
+		r.Required("start_date", req.StartDate)
+		start := r.Date("start_date", dateTimeLayout, req.StartDate)
+		end := r.Date("end_date", dateTimeLayout, req.EndDate)
+		r.Range("start_date", start, "end_date", end)
+	})
+	Register(func(req *pb.GetRecurringTransactionReq, r *Rules) {
+		r.Required("id", req.Id)
+	})
+	Register(func(req *pb.GetRecurringTransactionsListReq, r *Rules) {
+		r.Page("page", req.Page, req.Limit)
+		r.OneOf("status", req.Status, mongodb.RecurringStatusActive, mongodb.RecurringStatusPaused, mongodb.RecurringStatusCompleted)
+	})
+	Register(func(req *pb.UpdateRecurringTransactionReq, r *Rules) {
+		// Bo'sh maydonlar o'zgartirilmaydi
+		r.Required("id", req.Id)
+		r.NonNegative("amount", req.Amount)
+		r.OneOf("frequency", req.Frequency, frequencies...)
+		r.NonNegative("interval", int64(req.Interval))
+		r.Date("end_date", dateTimeLayout, req.EndDate)
+	})
+	Register(func(req *pb.DeleteRecurringTransactionReq, r *Rules) {
+		r.Required("id", req.Id)
+	})
+	Register(func(req *pb.SkipRecurringOccurrenceReq, r *Rules) {
+		r.Required("id", req.Id)
+		r.Required("date", req.Date)
+		r.Date("date", dayLayout, req.Date)
+	})
+	Register(func(req *pb.SetRecurringTransactionStatusReq, r *Rules) {
+		r.Required("id", req.Id)
+		r.Required("status", req.Status)
+		r.OneOf("status", req.Status, mongodb.RecurringStatusActive, mongodb.RecurringStatusPaused)
+	})
+
+	Register(func(req *pb.InviteAccountMemberReq, r *Rules) {
+		r.Required("account_id", req.AccountId)
+		r.Required("member_id", req.MemberId)
+		r.Required("role", req.Role)
+		r.OneOf("role", req.Role, mongodb.AccountRoleEditor, mongodb.AccountRoleViewer)
+	})
+	Register(func(req *pb.AcceptAccountInvitationReq, r *Rules) {
+		r.Required("invitation_id", req.InvitationId)
+	})
+	Register(func(req *pb.RevokeAccountMemberReq, r *Rules) {
+		r.Required("account_id", req.AccountId)
+		r.Required("member_id", req.MemberId)
+	})
+	Register(func(req *pb.GetAccountMembersReq, r *Rules) {
+		r.Required("account_id", req.AccountId)
+	})
+}
+
+// Goals management service so'rovlari
+func init() {
+	Register(func(req *pb.CreateGoalReq, r *Rules) {
+		r.Required("name", req.Name)
+		r.Positive("target_amount", req.TargetAmount)
+		r.NonNegative("current_amount", req.CurrentAmount)
+		r.Required("deadline", req.Deadline)
+		r.Date("deadline", dateTimeLayout, req.Deadline)
+	})
+	Register(func(req *pb.GetGoalsReq, r *Rules) {
+		r.Cursor("page", req.Page, req.Limit, req.PageToken)
+		r.Date("deadline", dateTimeLayout, req.Deadline)
+	})
+	Register(func(req *pb.GetGoalReq, r *Rules) {
+		r.Required("id", req.Id)
+	})
+	Register(func(req *pb.UpdateGoalReq, r *Rules) {
+		r.Required("id", req.Id)
+		r.Required("name", req.Name)
+		r.Positive("target_amount", req.TargetAmount)
+		r.Required("deadline", req.Deadline)
+		r.Date("deadline", dateTimeLayout, req.Deadline)
+	})
+	Register(func(req *pb.DeleteGoalReq, r *Rules) {
+		r.Required("id", req.Id)
+	})
+	Register(func(req *pb.ContributeToGoalReq, r *Rules) {
+		goalMovement(r, req.GoalId, req.Amount, req.Date)
+	})
+	Register(func(req *pb.WithdrawFromGoalReq, r *Rules) {
+		goalMovement(r, req.GoalId, req.Amount, req.Date)
+	})
+	Register(func(req *pb.GetGoalContributionsReq, r *Rules) {
+		r.Required("goal_id", req.GoalId)
+		r.Page("page", req.Page, req.Limit)
+	})
+}
+
+// Reporting va notification service so'rovlari
+func init() {
+	Register(func(req *pb.GetBudgetPerformanceReq, r *Rules) {
+		r.Between("year", int64(req.Year), 1970, 9999)
+		r.Between("month", int64(req.Month), 1, 12)
+		r.Between("history_periods", int64(req.HistoryPeriods), 0, 120)
+	})
+	Register(func(req *pb.GetSpendingByTagReq, r *Rules) {
+		start := r.Date("start_date", dateTimeLayout, req.StartDate)
+		end := r.Date("end_date", dateTimeLayout, req.EndDate)
+		r.Range("start_date", start, "end_date", end)
+		tags(r, req.Tags, false)
+	})
+	Register(func(req *pb.GetSpendingByCategoryReq, r *Rules) {
+		start := r.Date("start_date", dateTimeLayout, req.StartDate)
+		end := r.Date("end_date", dateTimeLayout, req.EndDate)
+		r.Range("start_date", start, "end_date", end)
+	})
+	Register(func(req *pb.ForecastGoalReq, r *Rules) {
+		r.Required("goal_id", req.GoalId)
+	})
+	Register(func(req *pb.SendNotificationReq, r *Rules) {
+		r.Required("user_id", req.UserId)
+		r.Required("type", req.Type)
+		r.Required("message", req.Message)
+	})
+	Register(func(req *pb.GetNotificationsListReq, r *Rules) {
+		// limit 0 - barcha bildirishnomalar
+		r.Between("limit", req.Limit, 0, MaxPageSize)
+	})
+	Register(func(req *pb.GetNotificationReq, r *Rules) {
+		r.Required("id", req.Id)
+	})
+	Register(func(req *pb.UpdateNotificationReq, r *Rules) {
+		r.Required("id", req.Id)
+	})
+	Register(func(req *pb.DeleteNotificationReq, r *Rules) {
+		r.Required("id", req.Id)
+	})
+	Register(func(req *pb.SetBudgetAlertThresholdsReq, r *Rules) {
+		for i, threshold := range req.Thresholds {
+			r.Between(fmt.Sprintf("thresholds[%d]", i), int64(threshold), 1, 1000)
+		}
+	})
+}
+
+// budgetPeriod byudjet davri maydonlari: davr turi, boshlanish va tugash sanasi.
+func budgetPeriod(r *Rules, period, startDate, endDate, carryOver string) {
+	r.Required("period", period)
+	r.OneOf("period", period, frequencies...)
+	r.Required("start_date", startDate)
+	r.Required("end_date", endDate)
+	start := r.Date("start_date", dateTimeLayout, startDate)
+	end := r.Date("end_date", dateTimeLayout, endDate)
+	r.Range("start_date", start, "end_date", end)
+	r.OneOf("carry_over", carryOver, mongodb.CarryOverNone, mongodb.CarryOverUnspent, mongodb.CarryOverAll)
+}
+
+// transactionFields oddiy (o'tkazma bo'lmagan) tranzaksiya maydonlari.
+func transactionFields(r *Rules, transactionType string, amount int64, date string) {
+	r.Required("type", transactionType)
+	r.OneOf("type", transactionType, mongodb.TransactionTypeIncome, mongodb.TransactionTypeExpense)
+	r.Positive("amount", amount)
+	r.Required("date", date)
+	r.Date("date", dateTimeLayout, date)
+}
+
+// splits bo'linishlar berilsa, har biri kategoriyali va musbat, yig'indisi esa
+// tranzaksiya summasiga teng bo'lishi kerak.
+func splits(r *Rules, values []*pb.TransactionSplit, amount int64, categoryId string) {
+	if len(values) == 0 {
+		return
+	}
+	if categoryId != "" {
+		r.Add("category_id", "must be empty when splits are given")
+	}
+	var total int64
+	for i, split := range values {
+		r.Required(fmt.Sprintf("splits[%d].category_id", i), split.CategoryId)
+		r.Positive(fmt.Sprintf("splits[%d].amount", i), split.Amount)
+		total += split.Amount
+	}
+	if total != amount {
+		r.Add("splits", "must sum to amount")
+	}
+}
+
+// tags har bir teg "#" va bo'shliqlarsiz ham bo'sh bo'lmasligi va MaxTagLength dan oshmasligi kerak.
+func tags(r *Rules, values []string, required bool) {
+	if required && len(values) == 0 {
+		r.Add("tags", "is required")
+	}
+	for i, tag := range values {
+		tag = mongodb.NormalizeTag(tag)
+		if tag == "" || len(tag) > MaxTagLength {
+			r.Add(fmt.Sprintf("tags[%d]", i), fmt.Sprintf("must be 1 to %d characters", MaxTagLength))
+		}
+	}
+}
+
+func categoryRule(r *Rules, name string, priority int32, values []string, rule rules.Rule) {
+	r.Required("name", name)
+	r.NonNegative("priority", int64(priority))
+	if !rule.HasConditions() {
+		r.Add("conditions", "at least one condition is required")
+	}
+	if _, err := rule.Compile(); err != nil {
+		r.Add("description_regex", err.Error())
+	}
+	r.NonNegative("min_amount", rule.MinAmount)
+	r.NonNegative("max_amount", rule.MaxAmount)
+	if rule.MaxAmount != 0 && rule.MaxAmount < rule.MinAmount {
+		r.Add("max_amount", "must not be less than min_amount")
+	}
+	r.OneOf("type", rule.Type, mongodb.TransactionTypeIncome, mongodb.TransactionTypeExpense)
+	if rule.CategoryId == "" && len(values) == 0 {
+		r.Add("category_id", "category_id or tags is required")
+	}
+	tags(r, values, false)
+}
+
+func goalMovement(r *Rules, goalId string, amount int64, date string) {
+	r.Required("goal_id", goalId)
+	r.Positive("amount", amount)
+	r.Date("date", dateTimeLayout, date)
+}
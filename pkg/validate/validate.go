@@ -0,0 +1,156 @@
+// Package validate RPC so'rovlarini handlerga yetib bormasdan tekshiradi.
+// Har bir so'rov turi uchun qoidalar Register orqali ro'yxatdan o'tkaziladi,
+// buzilgan qoidalar esa errdetails maydonlari bilan InvalidArgument bo'ladi.
+package validate
+
+import (
+	"budgeting-service/pkg/errs"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// MaxPageSize bitta sahifadagi elementlar soni chegarasi
+const MaxPageSize = 100
+
+// MaxTagLength tranzaksiya tegining eng katta uzunligi
+const MaxTagLength = 50
+
+// MaxIdempotencyKeyLength idempotency kalitining eng katta uzunligi
+const MaxIdempotencyKeyLength = 255
+
+// Rules bitta so'rov bo'yicha buzilgan qoidalarni yig'adi.
+type Rules struct {
+	violations []errs.FieldViolation
+}
+
+func (r *Rules) Add(field, description string) {
+	r.violations = append(r.violations, errs.FieldViolation{Field: field, Description: description})
+}
+
+// Required maydon bo'sh bo'lmasligi kerak.
+func (r *Rules) Required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		r.Add(field, "is required")
+	}
+}
+
+// Positive summa noldan katta bo'lishi kerak.
+func (r *Rules) Positive(field string, value int64) {
+	if value <= 0 {
+		r.Add(field, "must be positive")
+	}
+}
+
+// NonNegative summa manfiy bo'lmasligi kerak.
+func (r *Rules) NonNegative(field string, value int64) {
+	if value < 0 {
+		r.Add(field, "can not be negative")
+	}
+}
+
+// Between qiymat [min, max] oralig'ida bo'lishi kerak.
+func (r *Rules) Between(field string, value, min, max int64) {
+	if value < min || value > max {
+		r.Add(field, fmt.Sprintf("must be between %d and %d", min, max))
+	}
+}
+
+// OneOf bo'sh bo'lmagan qiymat ruxsat etilganlardan biri bo'lishi kerak
+// (katta-kichik harf farqlanmaydi). Majburiy maydonlar uchun Required bilan birga ishlatiladi.
+func (r *Rules) OneOf(field, value string, allowed ...string) {
+	if value == "" {
+		return
+	}
+	for _, a := range allowed {
+		if strings.EqualFold(value, a) {
+			return
+		}
+	}
+	r.Add(field, "must be one of "+strings.Join(allowed, ", "))
+}
+
+// Currency ISO 4217 uch harfli valyuta kodi: aynan uchta lotin harfi (A-Z,
+// katta-kichik harf farqlanmaydi). Baytlar tekshiriladi, chunki strings.ToUpper
+// ba'zi ASCII bo'lmagan harflarni ham lotin harfiga aylantiradi ("ſ" -> "S").
+func (r *Rules) Currency(field, value string) {
+	valid := len(value) == 3
+	for i := 0; valid && i < len(value); i++ {
+		c := value[i]
+		valid = 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z'
+	}
+	if !valid {
+		r.Add(field, "must be a 3-letter currency code")
+	}
+}
+
+// Date bo'sh bo'lmagan sana layout formatida bo'lishi kerak. O'qilgan sana
+// qaytariladi (bo'sh yoki noto'g'ri bo'lsa nol qiymat).
+func (r *Rules) Date(field, layout, value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+	date, err := time.Parse(layout, value)
+	if err != nil {
+		r.Add(field, "must be in "+layout+" format")
+	}
+	return date
+}
+
+// Range ikkala sana berilganda end start dan keyin bo'lishi kerak.
+func (r *Rules) Range(startField string, start time.Time, endField string, end time.Time) {
+	if !start.IsZero() && !end.IsZero() && !end.After(start) {
+		r.Add(endField, "must be after "+startField)
+	}
+}
+
+// Page sahifa raqami 1 dan, hajmi esa 1..MaxPageSize oralig'ida bo'lishi kerak.
+func (r *Rules) Page(pageField string, page int64, limit int64) {
+	if page < 1 {
+		r.Add(pageField, "must be at least 1")
+	}
+	r.Between("limit", limit, 1, MaxPageSize)
+}
+
+// Cursor page berilsa Page kabi tekshiradi, page 0 bo'lsa esa cursor rejimi
+// (page_token bo'yicha) deb qabul qilinadi. Ikkalasini birga berib bo'lmaydi.
+func (r *Rules) Cursor(pageField string, page int64, limit int64, token string) {
+	if page != 0 {
+		r.Page(pageField, page, limit)
+		if token != "" {
+			r.Add("page_token", "can not be combined with "+pageField)
+		}
+		return
+	}
+	r.Between("limit", limit, 1, MaxPageSize)
+}
+
+// Err buzilgan qoidalar bo'lsa, InvalidArgument xatosini qaytaradi.
+func (r *Rules) Err() error {
+	if len(r.violations) == 0 {
+		return nil
+	}
+	return errs.InvalidArgument(r.violations...)
+}
+
+var validators = map[reflect.Type]func(interface{}, *Rules){}
+
+// Register T turidagi so'rov uchun qoidalarni ro'yxatdan o'tkazadi.
+func Register[T any](rules func(req T, r *Rules)) {
+	var zero T
+	validators[reflect.TypeOf(zero)] = func(req interface{}, r *Rules) {
+		rules(req.(T), r)
+	}
+}
+
+// Request so'rovni tekshiradi. Qoidalari ro'yxatdan o'tmagan so'rovlar o'tkazib yuboriladi.
+func Request(req interface{}) error {
+	rules, ok := validators[reflect.TypeOf(req)]
+	if !ok {
+		return nil
+	}
+	var r Rules
+	rules(req, &r)
+	return r.Err()
+}
@@ -0,0 +1,213 @@
+package validate
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// violations xatodagi noto'g'ri maydonlar ro'yxati
+func violations(t *testing.T, err error) []string {
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	var fields []string
+	for _, detail := range st.Details() {
+		for _, v := range detail.(*errdetails.BadRequest).FieldViolations {
+			fields = append(fields, v.Field)
+		}
+	}
+	return fields
+}
+
+func TestCreateBudgetReq(t *testing.T) {
+	valid := &pb.CreateBudgetReq{
+		Amount:    100000,
+		Period:    "monthly",
+		StartDate: "2024-01-01 00:00:00",
+		EndDate:   "2024-01-31 23:59:59",
+	}
+	assert.NoError(t, Request(valid))
+
+	err := Request(&pb.CreateBudgetReq{
+		Amount:    -5,
+		Period:    "HOURLY",
+		StartDate: "2024-02-01 00:00:00",
+		EndDate:   "2024-01-01 00:00:00",
+		CarryOver: "SOME",
+	})
+	assert.ElementsMatch(t, []string{"amount", "period", "end_date", "carry_over"}, violations(t, err))
+}
+
+func TestTransactionRequests(t *testing.T) {
+	err := Request(&pb.CreateTransactionReq{Type: "gift", Amount: 0, Date: "01.01.2024"})
+	assert.ElementsMatch(t, []string{"account_id", "type", "amount", "date"}, violations(t, err))
+
+	err = Request(&pb.GetTransactionsListReq{Page: -1, Limit: 0})
+	assert.ElementsMatch(t, []string{"page", "limit"}, violations(t, err))
+
+	// page 0 - cursor rejimi
+	assert.NoError(t, Request(&pb.GetTransactionsListReq{Limit: 20, PageToken: "abc"}))
+
+	err = Request(&pb.GetTransactionsListReq{Page: 2, Limit: 20, PageToken: "abc"})
+	assert.ElementsMatch(t, []string{"page_token"}, violations(t, err))
+
+	err = Request(&pb.GetTransactionsListReq{Page: 1, Limit: MaxPageSize + 1})
+	assert.ElementsMatch(t, []string{"limit"}, violations(t, err))
+
+	err = Request(&pb.GetTransactionsListReq{Page: 1, Limit: 10, MinAmount: 500, MaxAmount: 100, SortBy: "name", SortOrder: "up"})
+	assert.ElementsMatch(t, []string{"max_amount", "sort_by", "sort_order"}, violations(t, err))
+
+	err = Request(&pb.CreateTransferReq{FromAccountId: "a", ToAccountId: "a", Amount: 10, Date: "2024-01-01 00:00:00"})
+	assert.ElementsMatch(t, []string{"to_account_id"}, violations(t, err))
+}
+
+func TestTransactionSplits(t *testing.T) {
+	valid := &pb.CreateTransactionReq{
+		AccountId: "acc",
+		Type:      "expense",
+		Amount:    1000,
+		Date:      "2024-01-01 00:00:00",
+		Splits: []*pb.TransactionSplit{
+			{CategoryId: "groceries", Amount: 700},
+			{CategoryId: "household", Amount: 300, Memo: "soap"},
+		},
+	}
+	assert.NoError(t, Request(valid))
+
+	err := Request(&pb.CreateTransactionReq{
+		AccountId:  "acc",
+		CategoryId: "groceries",
+		Type:       "expense",
+		Amount:     1000,
+		Date:       "2024-01-01 00:00:00",
+		Splits: []*pb.TransactionSplit{
+			{CategoryId: "", Amount: 700},
+			{CategoryId: "household", Amount: -100},
+		},
+	})
+	assert.ElementsMatch(t, []string{"category_id", "splits[0].category_id", "splits[1].amount", "splits"}, violations(t, err))
+}
+
+func TestTagRequests(t *testing.T) {
+	assert.NoError(t, Request(&pb.AddTransactionTagsReq{TransactionId: "tx", Tags: []string{"#Vacation", "reimbursable"}}))
+
+	err := Request(&pb.AddTransactionTagsReq{TransactionId: "tx", Tags: []string{"ok", " # ", strings.Repeat("a", MaxTagLength+1)}})
+	assert.ElementsMatch(t, []string{"tags[1]", "tags[2]"}, violations(t, err))
+
+	err = Request(&pb.RemoveTransactionTagsReq{})
+	assert.ElementsMatch(t, []string{"transaction_id", "tags"}, violations(t, err))
+}
+
+func TestDuplicateRequests(t *testing.T) {
+	assert.NoError(t, Request(&pb.CreateTransactionReq{AccountId: "acc", Type: "expense", Amount: 10, Date: "2024-01-01 00:00:00", IdempotencyKey: "retry-1"}))
+	err := Request(&pb.CreateTransactionReq{AccountId: "acc", Type: "expense", Amount: 10, Date: "2024-01-01 00:00:00", IdempotencyKey: strings.Repeat("k", MaxIdempotencyKeyLength+1)})
+	assert.ElementsMatch(t, []string{"idempotency_key"}, violations(t, err))
+
+	assert.NoError(t, Request(&pb.ListSuspectedDuplicatesReq{Limit: 20}))
+	err = Request(&pb.ListSuspectedDuplicatesReq{Limit: MaxPageSize + 1})
+	assert.ElementsMatch(t, []string{"limit"}, violations(t, err))
+
+	assert.NoError(t, Request(&pb.MergeDuplicatesReq{Id: "dup", KeepTransactionId: "tx"}))
+	err = Request(&pb.MergeDuplicatesReq{Dismiss: true, KeepTransactionId: "tx"})
+	assert.ElementsMatch(t, []string{"id", "keep_transaction_id"}, violations(t, err))
+}
+
+func TestImportStatementReq(t *testing.T) {
+	// Faqat chunk bo'lgan xabarlar tekshirilmaydi
+	assert.NoError(t, Request(&pb.ImportStatementReq{Chunk: []byte("data")}))
+	assert.NoError(t, Request(&pb.ImportStatementReq{AccountId: "acc", Format: "OFX"}))
+	assert.NoError(t, Request(&pb.ImportStatementReq{AccountId: "acc", Format: "csv", CsvMapping: &pb.CsvMapping{Date: "1", Debit: "2", Credit: "3"}}))
+
+	err := Request(&pb.ImportStatementReq{Format: "xls"})
+	assert.ElementsMatch(t, []string{"account_id", "format"}, violations(t, err))
+
+	err = Request(&pb.ImportStatementReq{AccountId: "acc", Format: "csv"})
+	assert.ElementsMatch(t, []string{"csv_mapping"}, violations(t, err))
+
+	err = Request(&pb.ImportStatementReq{AccountId: "acc", Format: "csv", CsvMapping: &pb.CsvMapping{Debit: "2", Delimiter: ";;", DecimalSeparator: "'"}})
+	assert.ElementsMatch(t, []string{"csv_mapping.date", "csv_mapping.amount", "csv_mapping.delimiter", "csv_mapping.decimal_separator"}, violations(t, err))
+}
+
+func TestCategoryRuleRequests(t *testing.T) {
+	assert.NoError(t, Request(&pb.CreateCategoryRuleReq{Name: "Coffee", DescriptionContains: "starbucks", CategoryId: "cat"}))
+	assert.NoError(t, Request(&pb.CreateCategoryRuleReq{Name: "Big", MinAmount: 100000, Type: "EXPENSE", Tags: []string{"review"}}))
+
+	err := Request(&pb.CreateCategoryRuleReq{Priority: -1})
+	assert.ElementsMatch(t, []string{"name", "priority", "conditions", "category_id"}, violations(t, err))
+
+	err = Request(&pb.UpdateCategoryRuleReq{Name: "Broken", DescriptionRegex: "[a-", MinAmount: 500, MaxAmount: 100, Type: "transfer_in", CategoryId: "cat"})
+	assert.ElementsMatch(t, []string{"id", "description_regex", "max_amount", "type"}, violations(t, err))
+
+	assert.NoError(t, Request(&pb.ApplyCategoryRulesReq{DateFrom: "2024-01-01 00:00:00"}))
+	err = Request(&pb.ApplyCategoryRulesReq{DateFrom: "2024-02-01 00:00:00", DateTo: "2024-01-01 00:00:00"})
+	assert.ElementsMatch(t, []string{"date_to"}, violations(t, err))
+}
+
+func TestSuggestCategoryReq(t *testing.T) {
+	assert.NoError(t, Request(&pb.SuggestCategoryReq{Description: "Starbucks", Amount: 550}))
+	assert.NoError(t, Request(&pb.SuggestCategoryReq{Counterparty: "Walmart", Limit: 5}))
+
+	err := Request(&pb.SuggestCategoryReq{Description: "  ", Amount: -1, Limit: MaxPageSize + 1})
+	assert.ElementsMatch(t, []string{"description", "amount", "limit"}, violations(t, err))
+
+	err = Request(&pb.UpdateTransactionReq{Id: "tx", Type: "expense", Amount: 10, Date: "2024-01-01 00:00:00", CategoryId: "cat", Splits: []*pb.TransactionSplit{{CategoryId: "a", Amount: 10}}})
+	assert.ElementsMatch(t, []string{"category_id"}, violations(t, err))
+}
+
+func TestCurrency(t *testing.T) {
+	for _, code := range []string{"USD", "eur", "Uzs"} {
+		assert.NoError(t, Request(&pb.SetBaseCurrencyReq{BaseCurrency: code}), code)
+	}
+	for _, code := range []string{"", "US", "USDT", "U1D", "US$", "uſd", "ÜSD"} {
+		err := Request(&pb.SetBaseCurrencyReq{BaseCurrency: code})
+		assert.Equal(t, []string{"base_currency"}, violations(t, err), code)
+	}
+}
+
+func TestCategoryTreeRequests(t *testing.T) {
+	assert.NoError(t, Request(&pb.MoveCategoryReq{Id: "groceries", ParentId: "food"}))
+	assert.NoError(t, Request(&pb.MoveCategoryReq{Id: "groceries"}))
+
+	err := Request(&pb.MoveCategoryReq{Id: "food", ParentId: "food"})
+	assert.ElementsMatch(t, []string{"parent_id"}, violations(t, err))
+
+	err = Request(&pb.MergeCategoriesReq{SourceId: "food", TargetId: "food"})
+	assert.ElementsMatch(t, []string{"target_id"}, violations(t, err))
+
+	err = Request(&pb.MergeCategoriesReq{})
+	assert.ElementsMatch(t, []string{"source_id", "target_id"}, violations(t, err))
+
+	assert.NoError(t, Request(&pb.DeleteCategoryReq{Id: "groceries", ReplacementCategoryId: "food"}))
+	err = Request(&pb.DeleteCategoryReq{Id: "food", ReplacementCategoryId: "food"})
+	assert.ElementsMatch(t, []string{"replacement_category_id"}, violations(t, err))
+
+	err = Request(&pb.GetSpendingByCategoryReq{StartDate: "2024-02-01 00:00:00", EndDate: "2024-01-01 00:00:00"})
+	assert.ElementsMatch(t, []string{"end_date"}, violations(t, err))
+}
+
+func TestUnregisteredRequest(t *testing.T) {
+	assert.NoError(t, Request(&pb.GetTrialBalanceReq{}))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+
+	_, err := UnaryServerInterceptor()(context.Background(), &pb.GetAccountReq{}, &grpc.UnaryServerInfo{}, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.False(t, called)
+
+	_, err = UnaryServerInterceptor()(context.Background(), &pb.GetAccountReq{Id: "acc"}, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
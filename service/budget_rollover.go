@@ -0,0 +1,63 @@
+package service
+
+import (
+	"budgeting-service/models"
+	"budgeting-service/storage"
+	"context"
+	"log/slog"
+	"time"
+)
+
+// BudgetRollover davri tugagan byudjetlarni keyingi davrga o'tkazadi. Servis uzoq
+// vaqt ishlamagan bo'lsa, o'tib ketgan har bir davr ketma-ket yopiladi.
+type BudgetRollover struct {
+	storage  storage.IStorage
+	logger   *slog.Logger
+	interval time.Duration
+}
+
+func NewBudgetRollover(storage storage.IStorage, logger *slog.Logger, interval time.Duration) *BudgetRollover {
+	return &BudgetRollover{
+		storage:  storage,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Run ctx bekor qilinguncha har interval da tugagan byudjetlarni yangilaydi.
+func (r *BudgetRollover) Run(ctx context.Context) {
+	runPeriodically(ctx, r.interval, r.RunDue)
+}
+
+// RunDue now gacha tugagan barcha byudjet davrlarini yopadi.
+func (r *BudgetRollover) RunDue(ctx context.Context, now time.Time) {
+	budgets, err := r.storage.BudgetManagementRepo().GetExpiredBudgets(ctx, now)
+	if err != nil {
+		r.logger.Error("Get expired budgets error", "error", err)
+		return
+	}
+	for _, budget := range budgets {
+		if err := r.rollover(ctx, budget, now); err != nil {
+			r.logger.Error("Budget rollover error", "id", budget.ID, "error", err)
+		}
+	}
+}
+
+func (r *BudgetRollover) rollover(ctx context.Context, budget models.GetBudget, now time.Time) error {
+	for budget.EndDate.Before(now) {
+		actual, err := r.storage.ReportingRepository().GetBudgetActual(ctx, budget)
+		if err != nil {
+			return err
+		}
+		next, err := r.storage.BudgetManagementRepo().RolloverBudget(ctx, budget, actual)
+		if err != nil {
+			return err
+		}
+		// Boshqa jarayon allaqachon yangilagan
+		if next == nil {
+			return nil
+		}
+		budget = *next
+	}
+	return nil
+}
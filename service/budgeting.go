@@ -13,6 +13,9 @@ type BudgetManagementService interface {
 	GetCategory(context.Context, *pb.GetCategoryReq) (*pb.GetCategoryResp, error)
 	UpdateCategory(context.Context, *pb.UpdateCategoryReq) (*pb.UpdateCategoryResp, error)
 	DeleteCategory(context.Context, *pb.DeleteCategoryReq) (*pb.DeleteCategoryResp, error)
+	SuggestCategory(context.Context, *pb.SuggestCategoryReq) (*pb.SuggestCategoryResp, error)
+	MoveCategory(context.Context, *pb.MoveCategoryReq) (*pb.MoveCategoryResp, error)
+	MergeCategories(context.Context, *pb.MergeCategoriesReq) (*pb.MergeCategoriesResp, error)
 	// Byudjetni boshqarish
 	CreateBudget(context.Context, *pb.CreateBudgetReq) (*pb.CreateBudgetResp, error)
 	GetBudgetsList(context.Context, *pb.GetBudgetsReq) (*pb.GetBudgetsResp, error)
@@ -125,3 +128,30 @@ func (s *budgetManagementServiceImpl) GetCategoriesList(ctx context.Context, req
 	}
 	return resp, nil
 }
+
+func (s *budgetManagementServiceImpl) SuggestCategory(ctx context.Context, req *pb.SuggestCategoryReq) (*pb.SuggestCategoryResp, error) {
+	resp, err := s.storage.CategoryRepository().SuggestCategory(ctx, req)
+	if err != nil {
+		s.logger.Error("Suggest category error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *budgetManagementServiceImpl) MoveCategory(ctx context.Context, req *pb.MoveCategoryReq) (*pb.MoveCategoryResp, error) {
+	resp, err := s.storage.CategoryRepository().MoveCategory(ctx, req)
+	if err != nil {
+		s.logger.Error("Move category error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *budgetManagementServiceImpl) MergeCategories(ctx context.Context, req *pb.MergeCategoriesReq) (*pb.MergeCategoriesResp, error) {
+	resp, err := s.storage.CategoryRepository().MergeCategories(ctx, req)
+	if err != nil {
+		s.logger.Error("Merge categories error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
@@ -3,9 +3,14 @@ package service
 import (
 	pb "budgeting-service/generated/budgeting"
 	"budgeting-service/models"
+	"budgeting-service/pkg/auth"
+	"budgeting-service/pkg/errs"
 	"budgeting-service/storage"
+	"budgeting-service/storage/mongodb"
 	"context"
+	"errors"
 	"log/slog"
+	"time"
 )
 
 type FinanceManagementService interface {
@@ -14,12 +19,50 @@ type FinanceManagementService interface {
 	GetAccount(context.Context, *pb.GetAccountReq) (*pb.GetAccountResp, error)
 	GetAccountsList(context.Context, *pb.GetAccountsListReq) (*pb.GetAccountsListResp, error)
 	DeleteAccount(context.Context, *pb.DeleteAccountReq) (*pb.DeleteAccountResp, error)
+	// Umumiy hisoblar
+	InviteAccountMember(context.Context, *pb.InviteAccountMemberReq) (*pb.InviteAccountMemberResp, error)
+	AcceptAccountInvitation(context.Context, *pb.AcceptAccountInvitationReq) (*pb.AcceptAccountInvitationResp, error)
+	RevokeAccountMember(context.Context, *pb.RevokeAccountMemberReq) (*pb.RevokeAccountMemberResp, error)
+	GetAccountMembers(context.Context, *pb.GetAccountMembersReq) (*pb.GetAccountMembersResp, error)
+	GetAccountInvitations(context.Context, *pb.GetAccountInvitationsReq) (*pb.GetAccountInvitationsResp, error)
 	// Tranzaksiyalarni boshqarish:
 	CreateTransaction(context.Context, *pb.CreateTransactionReq) (*pb.CreateTransactionResp, error)
 	UpdateTransaction(context.Context, *pb.UpdateTransactionReq) (*pb.UpdateTransactionResp, error)
 	GetTransaction(context.Context, *pb.GetTransactionReq) (*pb.GetTransactionResp, error)
 	GetTransactionsList(context.Context, *pb.GetTransactionsListReq) (*pb.GetTransactionsListResp, error)
 	DeleteTransaction(context.Context, *pb.DeleteTransactionReq) (*pb.DeleteTransactionResp, error)
+	CreateTransfer(context.Context, *pb.CreateTransferReq) (*pb.CreateTransferResp, error)
+	// Bank ko'chirmalarini import qilish
+	ImportStatement(pb.FinanceManagementService_ImportStatementServer) error
+	// Dublikatlar
+	ListSuspectedDuplicates(context.Context, *pb.ListSuspectedDuplicatesReq) (*pb.ListSuspectedDuplicatesResp, error)
+	MergeDuplicates(context.Context, *pb.MergeDuplicatesReq) (*pb.MergeDuplicatesResp, error)
+	// Avtomatik kategoriyalash qoidalari
+	CreateCategoryRule(context.Context, *pb.CreateCategoryRuleReq) (*pb.CreateCategoryRuleResp, error)
+	GetCategoryRule(context.Context, *pb.GetCategoryRuleReq) (*pb.GetCategoryRuleResp, error)
+	GetCategoryRulesList(context.Context, *pb.GetCategoryRulesListReq) (*pb.GetCategoryRulesListResp, error)
+	UpdateCategoryRule(context.Context, *pb.UpdateCategoryRuleReq) (*pb.UpdateCategoryRuleResp, error)
+	DeleteCategoryRule(context.Context, *pb.DeleteCategoryRuleReq) (*pb.DeleteCategoryRuleResp, error)
+	TestCategoryRules(context.Context, *pb.TestCategoryRulesReq) (*pb.TestCategoryRulesResp, error)
+	ApplyCategoryRules(context.Context, *pb.ApplyCategoryRulesReq) (*pb.ApplyCategoryRulesResp, error)
+	// Teglar va biriktirmalar
+	AddTransactionTags(context.Context, *pb.AddTransactionTagsReq) (*pb.AddTransactionTagsResp, error)
+	RemoveTransactionTags(context.Context, *pb.RemoveTransactionTagsReq) (*pb.RemoveTransactionTagsResp, error)
+	AddTransactionAttachment(context.Context, *pb.AddTransactionAttachmentReq) (*pb.AddTransactionAttachmentResp, error)
+	RemoveTransactionAttachment(context.Context, *pb.RemoveTransactionAttachmentReq) (*pb.RemoveTransactionAttachmentResp, error)
+	// Ikki tomonlama yozuvlar (double-entry)
+	GetTrialBalance(context.Context, *pb.GetTrialBalanceReq) (*pb.GetTrialBalanceResp, error)
+	// Valyuta kurslari
+	SetExchangeRates(context.Context, *pb.SetExchangeRatesReq) (*pb.SetExchangeRatesResp, error)
+	SetBaseCurrency(context.Context, *pb.SetBaseCurrencyReq) (*pb.SetBaseCurrencyResp, error)
+	// Takrorlanuvchi tranzaksiyalar
+	CreateRecurringTransaction(context.Context, *pb.CreateRecurringTransactionReq) (*pb.CreateRecurringTransactionResp, error)
+	GetRecurringTransaction(context.Context, *pb.GetRecurringTransactionReq) (*pb.GetRecurringTransactionResp, error)
+	GetRecurringTransactionsList(context.Context, *pb.GetRecurringTransactionsListReq) (*pb.GetRecurringTransactionsListResp, error)
+	UpdateRecurringTransaction(context.Context, *pb.UpdateRecurringTransactionReq) (*pb.UpdateRecurringTransactionResp, error)
+	DeleteRecurringTransaction(context.Context, *pb.DeleteRecurringTransactionReq) (*pb.DeleteRecurringTransactionResp, error)
+	SkipRecurringOccurrence(context.Context, *pb.SkipRecurringOccurrenceReq) (*pb.SkipRecurringOccurrenceResp, error)
+	SetRecurringTransactionStatus(context.Context, *pb.SetRecurringTransactionStatusReq) (*pb.SetRecurringTransactionStatusResp, error)
 }
 
 type financeManagementServiceImpl struct {
@@ -82,38 +125,144 @@ func (s *financeManagementServiceImpl) GetAccountsList(ctx context.Context, req
 
 // <---------------------------------------------------------------------->
 
+func (s *financeManagementServiceImpl) InviteAccountMember(ctx context.Context, req *pb.InviteAccountMemberReq) (*pb.InviteAccountMemberResp, error) {
+	resp, err := s.storage.AccountMemberRepository().InviteAccountMember(ctx, req)
+	if err != nil {
+		s.logger.Error("Invite account member error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) AcceptAccountInvitation(ctx context.Context, req *pb.AcceptAccountInvitationReq) (*pb.AcceptAccountInvitationResp, error) {
+	resp, err := s.storage.AccountMemberRepository().AcceptAccountInvitation(ctx, req)
+	if err != nil {
+		s.logger.Error("Accept account invitation error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) RevokeAccountMember(ctx context.Context, req *pb.RevokeAccountMemberReq) (*pb.RevokeAccountMemberResp, error) {
+	resp, err := s.storage.AccountMemberRepository().RevokeAccountMember(ctx, req)
+	if err != nil {
+		s.logger.Error("Revoke account member error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) GetAccountMembers(ctx context.Context, req *pb.GetAccountMembersReq) (*pb.GetAccountMembersResp, error) {
+	resp, err := s.storage.AccountMemberRepository().GetAccountMembers(ctx, req)
+	if err != nil {
+		s.logger.Error("Get account members error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) GetAccountInvitations(ctx context.Context, req *pb.GetAccountInvitationsReq) (*pb.GetAccountInvitationsResp, error) {
+	resp, err := s.storage.AccountMemberRepository().GetAccountInvitations(ctx, req)
+	if err != nil {
+		s.logger.Error("Get account invitations error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
 func (s *financeManagementServiceImpl) CreateTransaction(ctx context.Context, req *pb.CreateTransactionReq) (*pb.CreateTransactionResp, error) {
 	resp, err := s.storage.TransactionRepository().CreateTransaction(ctx, req)
+	// Shu idempotency kaliti yoki id bilan foydalanuvchining o'zi yaratgan
+	// tranzaksiya qayta yaratilmaydi
+	if errors.Is(err, mongodb.ErrTransactionExists) && resp.GetId() != "" {
+		return &pb.CreateTransactionResp{
+			Status:  "success",
+			Message: "transaction already created",
+			Id:      resp.GetId(),
+		}, nil
+	}
 	if err != nil {
 		s.logger.Error("Create transaction error", "error", err)
 		return resp, err
 	}
-	err = s.storage.AccountBalance().SetBalance(ctx, models.Balance{
-		AccountId: req.GetAccountId(),
-		Balance:   -req.GetAmount(),
-	})
+	err = refreshBalance(ctx, s.storage, req.GetUserId(), req.GetAccountId())
 	if err != nil {
 		s.logger.Error("Set balance error", "error", err)
 		return resp, err
 	}
+	// Ogohlantirish xatosi tranzaksiyani bekor qilmaydi
+	if err := checkBudgetAlerts(ctx, s.storage, req, resp.GetCategoryId()); err != nil {
+		s.logger.Error("Check budget alerts error", "error", err)
+	}
 	return resp, nil
 }
 
 func (s *financeManagementServiceImpl) UpdateTransaction(ctx context.Context, req *pb.UpdateTransactionReq) (*pb.UpdateTransactionResp, error) {
+	transaction, err := s.storage.TransactionRepository().GetTransaction(ctx, &pb.GetTransactionReq{Id: req.GetId(), UserId: req.GetUserId()})
+	if err != nil {
+		s.logger.Error("Get transaction error", "error", err)
+		return nil, err
+	}
 	resp, err := s.storage.TransactionRepository().UpdateTransaction(ctx, req)
 	if err != nil {
 		s.logger.Error("Update transaction error", "error", err)
 		return resp, err
 	}
+	err = refreshBalance(ctx, s.storage, req.GetUserId(), transaction.GetAccountId())
+	if err != nil {
+		s.logger.Error("Set balance error", "error", err)
+		return resp, err
+	}
 	return resp, nil
 }
 
 func (s *financeManagementServiceImpl) DeleteTransaction(ctx context.Context, req *pb.DeleteTransactionReq) (*pb.DeleteTransactionResp, error) {
+	transaction, err := s.storage.TransactionRepository().GetTransaction(ctx, &pb.GetTransactionReq{Id: req.GetId(), UserId: req.GetUserId()})
+	if err != nil {
+		s.logger.Error("Get transaction error", "error", err)
+		return nil, err
+	}
+	accountIds := []string{transaction.GetAccountId()}
+	if transaction.GetTransferId() != "" {
+		legs, err := s.storage.TransactionRepository().GetTransferLegs(ctx, transaction.GetTransferId())
+		if err != nil {
+			s.logger.Error("Get transfer legs error", "error", err)
+			return nil, err
+		}
+		accountIds = accountIds[:0]
+		for _, leg := range legs {
+			accountIds = append(accountIds, leg.AccountId)
+		}
+	}
+
 	resp, err := s.storage.TransactionRepository().DeleteTransaction(ctx, req)
 	if err != nil {
 		s.logger.Error("Delete transaction error", "error", err)
 		return resp, err
 	}
+	for _, accountId := range accountIds {
+		err = refreshBalance(ctx, s.storage, req.GetUserId(), accountId)
+		if err != nil {
+			s.logger.Error("Set balance error", "error", err)
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) CreateTransfer(ctx context.Context, req *pb.CreateTransferReq) (*pb.CreateTransferResp, error) {
+	resp, err := s.storage.TransactionRepository().CreateTransfer(ctx, req)
+	if err != nil {
+		s.logger.Error("Create transfer error", "error", err)
+		return resp, err
+	}
+	for _, accountId := range []string{req.GetFromAccountId(), req.GetToAccountId()} {
+		err = refreshBalance(ctx, s.storage, req.GetUserId(), accountId)
+		if err != nil {
+			s.logger.Error("Set balance error", "error", err)
+			return resp, err
+		}
+	}
 	return resp, nil
 }
 
@@ -134,3 +283,265 @@ func (s *financeManagementServiceImpl) GetTransactionsList(ctx context.Context,
 	}
 	return resp, nil
 }
+
+// Teglar va biriktirmalar
+func (s *financeManagementServiceImpl) ListSuspectedDuplicates(ctx context.Context, req *pb.ListSuspectedDuplicatesReq) (*pb.ListSuspectedDuplicatesResp, error) {
+	resp, err := s.storage.TransactionRepository().ListSuspectedDuplicates(ctx, req)
+	if err != nil {
+		s.logger.Error("List suspected duplicates error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) MergeDuplicates(ctx context.Context, req *pb.MergeDuplicatesReq) (*pb.MergeDuplicatesResp, error) {
+	resp, err := s.storage.TransactionRepository().MergeDuplicates(ctx, req)
+	if err != nil {
+		s.logger.Error("Merge duplicates error", "error", err)
+		return resp, err
+	}
+	if resp.GetKeptTransactionId() == "" {
+		return resp, nil
+	}
+	// Ikkala tranzaksiya bir hisobda, o'chirilgani balansdan chiqarildi
+	kept, err := s.storage.TransactionRepository().GetTransaction(ctx, &pb.GetTransactionReq{Id: resp.GetKeptTransactionId(), UserId: req.GetUserId()})
+	if err != nil {
+		s.logger.Error("Get transaction error", "error", err)
+		return resp, err
+	}
+	err = refreshBalance(ctx, s.storage, req.GetUserId(), kept.GetAccountId())
+	if err != nil {
+		s.logger.Error("Set balance error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) CreateCategoryRule(ctx context.Context, req *pb.CreateCategoryRuleReq) (*pb.CreateCategoryRuleResp, error) {
+	resp, err := s.storage.CategoryRuleRepository().CreateCategoryRule(ctx, req)
+	if err != nil {
+		s.logger.Error("Create category rule error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) GetCategoryRule(ctx context.Context, req *pb.GetCategoryRuleReq) (*pb.GetCategoryRuleResp, error) {
+	resp, err := s.storage.CategoryRuleRepository().GetCategoryRule(ctx, req)
+	if err != nil {
+		s.logger.Error("Get category rule error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) GetCategoryRulesList(ctx context.Context, req *pb.GetCategoryRulesListReq) (*pb.GetCategoryRulesListResp, error) {
+	resp, err := s.storage.CategoryRuleRepository().GetCategoryRulesList(ctx, req)
+	if err != nil {
+		s.logger.Error("Get category rules list error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) UpdateCategoryRule(ctx context.Context, req *pb.UpdateCategoryRuleReq) (*pb.UpdateCategoryRuleResp, error) {
+	resp, err := s.storage.CategoryRuleRepository().UpdateCategoryRule(ctx, req)
+	if err != nil {
+		s.logger.Error("Update category rule error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) DeleteCategoryRule(ctx context.Context, req *pb.DeleteCategoryRuleReq) (*pb.DeleteCategoryRuleResp, error) {
+	resp, err := s.storage.CategoryRuleRepository().DeleteCategoryRule(ctx, req)
+	if err != nil {
+		s.logger.Error("Delete category rule error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) TestCategoryRules(ctx context.Context, req *pb.TestCategoryRulesReq) (*pb.TestCategoryRulesResp, error) {
+	resp, err := s.storage.CategoryRuleRepository().TestCategoryRules(ctx, req)
+	if err != nil {
+		s.logger.Error("Test category rules error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) ApplyCategoryRules(ctx context.Context, req *pb.ApplyCategoryRulesReq) (*pb.ApplyCategoryRulesResp, error) {
+	resp, err := s.storage.CategoryRuleRepository().ApplyCategoryRules(ctx, req)
+	if err != nil {
+		s.logger.Error("Apply category rules error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) AddTransactionTags(ctx context.Context, req *pb.AddTransactionTagsReq) (*pb.AddTransactionTagsResp, error) {
+	resp, err := s.storage.TransactionRepository().AddTransactionTags(ctx, req)
+	if err != nil {
+		s.logger.Error("Add transaction tags error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) RemoveTransactionTags(ctx context.Context, req *pb.RemoveTransactionTagsReq) (*pb.RemoveTransactionTagsResp, error) {
+	resp, err := s.storage.TransactionRepository().RemoveTransactionTags(ctx, req)
+	if err != nil {
+		s.logger.Error("Remove transaction tags error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) AddTransactionAttachment(ctx context.Context, req *pb.AddTransactionAttachmentReq) (*pb.AddTransactionAttachmentResp, error) {
+	resp, err := s.storage.TransactionRepository().AddTransactionAttachment(ctx, req)
+	if err != nil {
+		s.logger.Error("Add transaction attachment error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) RemoveTransactionAttachment(ctx context.Context, req *pb.RemoveTransactionAttachmentReq) (*pb.RemoveTransactionAttachmentResp, error) {
+	resp, err := s.storage.TransactionRepository().RemoveTransactionAttachment(ctx, req)
+	if err != nil {
+		s.logger.Error("Remove transaction attachment error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) GetTrialBalance(ctx context.Context, req *pb.GetTrialBalanceReq) (*pb.GetTrialBalanceResp, error) {
+	resp, err := s.storage.JournalRepository().GetTrialBalance(ctx, req)
+	if err != nil {
+		s.logger.Error("Get trial balance error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+// SetExchangeRates barcha foydalanuvchilar hisobotlari ishlatadigan kurslar
+// jadvalini o'zgartiradi, shuning uchun faqat admin uchun.
+func (s *financeManagementServiceImpl) SetExchangeRates(ctx context.Context, req *pb.SetExchangeRatesReq) (*pb.SetExchangeRatesResp, error) {
+	if !auth.IsAdmin(ctx) {
+		err := errs.PermissionDenied("only admins can set exchange rates")
+		s.logger.Error("Set exchange rates error", "error", err)
+		return nil, err
+	}
+	resp, err := s.storage.ExchangeRateRepository().SetExchangeRates(ctx, req)
+	if err != nil {
+		s.logger.Error("Set exchange rates error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) SetBaseCurrency(ctx context.Context, req *pb.SetBaseCurrencyReq) (*pb.SetBaseCurrencyResp, error) {
+	resp, err := s.storage.UserSettingsRepository().SetBaseCurrency(ctx, req)
+	if err != nil {
+		s.logger.Error("Set base currency error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) CreateRecurringTransaction(ctx context.Context, req *pb.CreateRecurringTransactionReq) (*pb.CreateRecurringTransactionResp, error) {
+	resp, err := s.storage.RecurringTransactionRepository().CreateRecurringTransaction(ctx, req)
+	if err != nil {
+		s.logger.Error("Create recurring transaction error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) GetRecurringTransaction(ctx context.Context, req *pb.GetRecurringTransactionReq) (*pb.GetRecurringTransactionResp, error) {
+	resp, err := s.storage.RecurringTransactionRepository().GetRecurringTransaction(ctx, req)
+	if err != nil {
+		s.logger.Error("Get recurring transaction error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) GetRecurringTransactionsList(ctx context.Context, req *pb.GetRecurringTransactionsListReq) (*pb.GetRecurringTransactionsListResp, error) {
+	resp, err := s.storage.RecurringTransactionRepository().GetRecurringTransactionsList(ctx, req)
+	if err != nil {
+		s.logger.Error("Get recurring transactions list error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) UpdateRecurringTransaction(ctx context.Context, req *pb.UpdateRecurringTransactionReq) (*pb.UpdateRecurringTransactionResp, error) {
+	resp, err := s.storage.RecurringTransactionRepository().UpdateRecurringTransaction(ctx, req)
+	if err != nil {
+		s.logger.Error("Update recurring transaction error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) DeleteRecurringTransaction(ctx context.Context, req *pb.DeleteRecurringTransactionReq) (*pb.DeleteRecurringTransactionResp, error) {
+	resp, err := s.storage.RecurringTransactionRepository().DeleteRecurringTransaction(ctx, req)
+	if err != nil {
+		s.logger.Error("Delete recurring transaction error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) SkipRecurringOccurrence(ctx context.Context, req *pb.SkipRecurringOccurrenceReq) (*pb.SkipRecurringOccurrenceResp, error) {
+	resp, err := s.storage.RecurringTransactionRepository().SkipRecurringOccurrence(ctx, req)
+	if err != nil {
+		s.logger.Error("Skip recurring occurrence error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *financeManagementServiceImpl) SetRecurringTransactionStatus(ctx context.Context, req *pb.SetRecurringTransactionStatusReq) (*pb.SetRecurringTransactionStatusResp, error) {
+	resp, err := s.storage.RecurringTransactionRepository().SetRecurringTransactionStatus(ctx, req)
+	if err != nil {
+		s.logger.Error("Set recurring transaction status error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+// checkBudgetAlerts xarajat tranzaksiyasidan keyin kategoriya byudjetlarining
+// ogohlantirish chegaralarini tekshiradi. Bo'lingan tranzaksiyada har bir
+// bo'linish kategoriyasi tekshiriladi.
+func checkBudgetAlerts(ctx context.Context, storage storage.IStorage, transaction *pb.CreateTransactionReq, categoryId string) error {
+	categoryIds := mongodb.TransactionCategories(categoryId, transaction.GetSplits())
+	if transaction.GetType() != mongodb.TransactionTypeExpense || len(categoryIds) == 0 {
+		return nil
+	}
+	date, err := time.Parse("2006-01-02 15:04:05", transaction.GetDate())
+	if err != nil {
+		return err
+	}
+	for _, categoryId := range categoryIds {
+		err := storage.BudgetAlertRepository().CheckBudgetAlerts(ctx, transaction.GetUserId(), transaction.GetAccountId(), categoryId, date)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// refreshBalance Redis keshidagi balansni MongoDB dagi haqiqiy balans bilan yangilaydi.
+func refreshBalance(ctx context.Context, storage storage.IStorage, userId, accountId string) error {
+	account, err := storage.AccountRepository().GetAccount(ctx, &pb.GetAccountReq{Id: accountId, UserId: userId})
+	if err != nil {
+		return err
+	}
+	return storage.AccountBalance().SetBalance(ctx, models.Balance{
+		AccountId: accountId,
+		Balance:   account.GetBalance(),
+	})
+}
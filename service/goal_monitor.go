@@ -0,0 +1,44 @@
+package service
+
+import (
+	"budgeting-service/storage"
+	"context"
+	"log/slog"
+	"time"
+)
+
+// GoalMonitor maqsadlarni muntazam prognoz qiladi va muddatdan ortda qola
+// boshlagan maqsadlar haqida bildirishnoma yuboradi.
+type GoalMonitor struct {
+	storage  storage.IStorage
+	logger   *slog.Logger
+	interval time.Duration
+}
+
+func NewGoalMonitor(storage storage.IStorage, logger *slog.Logger, interval time.Duration) *GoalMonitor {
+	return &GoalMonitor{
+		storage:  storage,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Run ctx bekor qilinguncha har interval da maqsadlarni tekshiradi.
+func (m *GoalMonitor) Run(ctx context.Context) {
+	runPeriodically(ctx, m.interval, m.RunDue)
+}
+
+// RunDue barcha bajarilmagan maqsadlarni tekshiradi. Bitta maqsaddagi xato
+// (masalan, valyuta kursi yo'qligi) qolgan maqsadlarni to'xtatmaydi.
+func (m *GoalMonitor) RunDue(ctx context.Context, now time.Time) {
+	goals, err := m.storage.ReportingRepository().GetGoalsInProgress(ctx)
+	if err != nil {
+		m.logger.Error("Get goals in progress error", "error", err)
+		return
+	}
+	for _, goal := range goals {
+		if err := m.storage.ReportingRepository().CheckGoalAtRisk(ctx, goal, now); err != nil {
+			m.logger.Error("Check goal at risk error", "id", goal.ID, "user_id", goal.UserId, "error", err)
+		}
+	}
+}
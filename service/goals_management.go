@@ -13,6 +13,10 @@ type GoalsManagementService interface {
 	DeleteGoal(ctx context.Context, req *pb.DeleteGoalReq) (*pb.DeleteGoalResp, error)
 	GetGoal(ctx context.Context, req *pb.GetGoalReq) (*pb.GetGoalResp, error)
 	GetGoalsList(ctx context.Context, req *pb.GetGoalsReq) (*pb.GetGoalsResp, error)
+	// Maqsadga jamg'arish
+	ContributeToGoal(ctx context.Context, req *pb.ContributeToGoalReq) (*pb.ContributeToGoalResp, error)
+	WithdrawFromGoal(ctx context.Context, req *pb.WithdrawFromGoalReq) (*pb.WithdrawFromGoalResp, error)
+	GetGoalContributions(ctx context.Context, req *pb.GetGoalContributionsReq) (*pb.GetGoalContributionsResp, error)
 }
 
 type goalsManagementServiceImpl struct {
@@ -72,3 +76,56 @@ func (s *goalsManagementServiceImpl) GetGoals(ctx context.Context, req *pb.GetGo
 	}
 	return resp, nil
 }
+
+func (s *goalsManagementServiceImpl) ContributeToGoal(ctx context.Context, req *pb.ContributeToGoalReq) (*pb.ContributeToGoalResp, error) {
+	resp, err := s.storage.GoalsRepository().ContributeToGoal(ctx, req)
+	if err != nil {
+		s.logger.Error("Contribute to goal error", "error", err)
+		return resp, err
+	}
+	if err := s.refreshSavingsBalances(ctx, req.GetUserId(), req.GetGoalId(), req.GetAccountId(), req.GetTransactionId()); err != nil {
+		s.logger.Error("Set balance error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *goalsManagementServiceImpl) WithdrawFromGoal(ctx context.Context, req *pb.WithdrawFromGoalReq) (*pb.WithdrawFromGoalResp, error) {
+	resp, err := s.storage.GoalsRepository().WithdrawFromGoal(ctx, req)
+	if err != nil {
+		s.logger.Error("Withdraw from goal error", "error", err)
+		return resp, err
+	}
+	if err := s.refreshSavingsBalances(ctx, req.GetUserId(), req.GetGoalId(), req.GetAccountId(), req.GetTransactionId()); err != nil {
+		s.logger.Error("Set balance error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *goalsManagementServiceImpl) GetGoalContributions(ctx context.Context, req *pb.GetGoalContributionsReq) (*pb.GetGoalContributionsResp, error) {
+	resp, err := s.storage.GoalsRepository().GetGoalContributions(ctx, req)
+	if err != nil {
+		s.logger.Error("Get goal contributions error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+// refreshSavingsBalances jamg'arma o'tkazma bilan bajarilgan bo'lsa, ikkala hisob
+// balansini Redis da yangilaydi.
+func (s *goalsManagementServiceImpl) refreshSavingsBalances(ctx context.Context, userId, goalId, accountId, transactionId string) error {
+	if accountId == "" || transactionId != "" {
+		return nil
+	}
+	goal, err := s.storage.GoalsRepository().GetGoal(ctx, &pb.GetGoalReq{Id: goalId, UserId: userId})
+	if err != nil {
+		return err
+	}
+	for _, id := range []string{accountId, goal.GetSavingsAccountId()} {
+		if err := refreshBalance(ctx, s.storage, userId, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,10 +2,13 @@ package service
 
 import (
 	pb "budgeting-service/generated/budgeting"
-	"budgeting-service/models"
 	"budgeting-service/storage"
+	"budgeting-service/storage/mongodb"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"log"
 	"log/slog"
 )
@@ -38,19 +41,36 @@ func (m *msBorokerServiceImpl) CreateTransaction(msg []byte) {
 		m.logger.Error("Error unmarshalling transaction message", "error", err)
 		return
 	}
-	_, err = m.storage.TransactionRepository().CreateTransaction(ctx, &transaction)
+	// Qayta yetkazilgan xabar ikkinchi tranzaksiya yaratmasligi uchun: kalit
+	// berilmagan bo'lsa, xabarning o'zidan hosil qilinadi
+	if transaction.Id == "" && transaction.IdempotencyKey == "" {
+		transaction.IdempotencyKey = messageIdempotencyKey(msg)
+	}
+	created, err := m.storage.TransactionRepository().CreateTransaction(ctx, &transaction)
+	if errors.Is(err, mongodb.ErrTransactionExists) {
+		m.logger.Info("Transaction message already processed", "idempotency_key", transaction.IdempotencyKey, "id", transaction.Id)
+		return
+	}
 	if err != nil {
 		m.logger.Error("Create transaction error", "error", err)
 		return
 	}
-	err = m.storage.AccountBalance().SetBalance(ctx, models.Balance{
-		AccountId: transaction.GetAccountId(),
-		Balance:   -transaction.GetAmount(),
-	})
+	err = refreshBalance(ctx, m.storage, transaction.GetUserId(), transaction.GetAccountId())
 	if err != nil {
 		m.logger.Error("Set balance error", "error", err)
 		return
 	}
+	err = checkBudgetAlerts(ctx, m.storage, &transaction, created.GetCategoryId())
+	if err != nil {
+		m.logger.Error("Check budget alerts error", "error", err)
+		return
+	}
+}
+
+// messageIdempotencyKey xabar tarkibidan idempotency kaliti hosil qiladi.
+func messageIdempotencyKey(msg []byte) string {
+	sum := sha256.Sum256(msg)
+	return "kafka:" + hex.EncodeToString(sum[:])
 }
 
 func (m *msBorokerServiceImpl) UpdateBudget(msg []byte) {
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// runPeriodically fn ni darhol, keyin esa ctx bekor qilinguncha har interval da chaqiradi.
+func runPeriodically(ctx context.Context, interval time.Duration, fn func(ctx context.Context, now time.Time)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		fn(ctx, time.Now())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
+	"budgeting-service/storage"
+	"budgeting-service/storage/mongodb"
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// RecurringScheduler takrorlanuvchi tranzaksiyalarning vaqti kelgan sanalarini
+// oddiy tranzaksiyaga aylantiradi. Servis to'xtab qolgan bo'lsa, qayta ishga
+// tushganda o'tib ketgan sanalar ham yaratiladi.
+type RecurringScheduler struct {
+	storage  storage.IStorage
+	logger   *slog.Logger
+	interval time.Duration
+}
+
+func NewRecurringScheduler(storage storage.IStorage, logger *slog.Logger, interval time.Duration) *RecurringScheduler {
+	return &RecurringScheduler{
+		storage:  storage,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Run ctx bekor qilinguncha har interval da vaqti kelgan qoidalarni bajaradi.
+func (s *RecurringScheduler) Run(ctx context.Context) {
+	runPeriodically(ctx, s.interval, s.RunDue)
+}
+
+// RunDue now gacha bo'lgan barcha sanalar uchun tranzaksiyalarni yaratadi.
+func (s *RecurringScheduler) RunDue(ctx context.Context, now time.Time) {
+	rules, err := s.storage.RecurringTransactionRepository().GetDueRecurringTransactions(ctx, now)
+	if err != nil {
+		s.logger.Error("Get due recurring transactions error", "error", err)
+		return
+	}
+	for _, rule := range rules {
+		err := s.materialize(ctx, rule, now)
+		if err == nil {
+			continue
+		}
+		s.logger.Error("Materialize recurring transaction error", "id", rule.ID, "error", err)
+		// Hisob yoki kategoriya o'chirilgan, huquq olib qo'yilgan: qayta urinish
+		// foyda bermaydi, shuning uchun qoida to'xtatiladi
+		if permanentRecurringError(err) {
+			if err := s.storage.RecurringTransactionRepository().PauseRecurringTransaction(ctx, rule.ID); err != nil {
+				s.logger.Error("Pause recurring transaction error", "id", rule.ID, "error", err)
+			} else {
+				s.logger.Warn("Recurring transaction paused", "id", rule.ID)
+			}
+		}
+	}
+}
+
+// permanentRecurringError qoidani keyingi safar ham bajarib bo'lmasligini bildiradi.
+func permanentRecurringError(err error) bool {
+	switch errs.KindOf(err) {
+	case errs.KindNotFound, errs.KindPermissionDenied, errs.KindInvalidArgument:
+		return true
+	}
+	return false
+}
+
+// materialize qoidaning next_run dan now gacha bo'lgan sanalarini yaratadi. Har bir
+// sanadan keyin next_run saqlanadi; tranzaksiya id si sanadan hosil qilingani uchun
+// ikki marta ishga tushish dublikat yaratmaydi.
+func (s *RecurringScheduler) materialize(ctx context.Context, rule models.RecurringTransaction, now time.Time) error {
+	schedule := mongodb.RecurringSchedule(rule)
+	occurrence, ok := rule.NextRun, true
+
+	for ok && !occurrence.After(now) {
+		if !mongodb.IsSkipped(rule, occurrence) {
+			transaction := &pb.CreateTransactionReq{
+				Id:          mongodb.OccurrenceTransactionId(rule.ID, occurrence),
+				AccountId:   rule.AccountId,
+				UserId:      rule.UserId,
+				CategoryId:  rule.CategoryId,
+				Amount:      rule.Amount,
+				Type:        rule.Type,
+				Description: rule.Description,
+				Date:        occurrence.Format("2006-01-02 15:04:05"),
+			}
+			created, err := s.storage.TransactionRepository().CreateTransaction(ctx, transaction)
+			if err == nil {
+				if err := checkBudgetAlerts(ctx, s.storage, transaction, created.GetCategoryId()); err != nil {
+					s.logger.Error("Check budget alerts error", "error", err)
+				}
+			} else if !errors.Is(err, mongodb.ErrTransactionExists) {
+				return err
+			}
+		}
+
+		occurrence, ok = schedule.Next(occurrence)
+		err := s.storage.RecurringTransactionRepository().AdvanceRecurringTransaction(ctx, rule.ID, occurrence, !ok)
+		if err != nil {
+			return err
+		}
+	}
+
+	return refreshBalance(ctx, s.storage, rule.UserId, rule.AccountId)
+}
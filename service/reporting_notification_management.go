@@ -10,14 +10,19 @@ import (
 type ReportingNotifcation interface {
 	GetSependingReport(ctx context.Context, request *pb.GetSependingReq) (*pb.GetSependingResp, error)
 	GetIncomeReport(ctx context.Context, request *pb.GetIncomeReportReq) (*pb.GetIncomeReportResp, error)
+	GetSpendingByTag(ctx context.Context, request *pb.GetSpendingByTagReq) (*pb.GetSpendingByTagResp, error)
+	GetSpendingByCategory(ctx context.Context, request *pb.GetSpendingByCategoryReq) (*pb.GetSpendingByCategoryResp, error)
 	GetBudgetPerformance(ctx context.Context, request *pb.GetBudgetPerformanceReq) (*pb.GetBudgetPerformanceResp, error)
 	GetGoalsProgress(ctx context.Context, request *pb.GetGoalProgressReq) (*pb.GetGoalProgressResp, error)
+	ForecastGoal(ctx context.Context, request *pb.ForecastGoalReq) (*pb.ForecastGoalResp, error)
 
 	SendNotification(context.Context, *pb.SendNotificationReq) (*pb.SendNotificationResp, error)
 	GetNotificationList(context.Context, *pb.GetNotificationsListReq) (*pb.GetNotificationsListResp, error)
 	GetNotification(context.Context, *pb.GetNotificationReq) (*pb.GetNotificationResp, error)
 	UpdateNotification(context.Context, *pb.UpdateNotificationReq) (*pb.UpdateNotificationResp, error)
 	DeleteNotification(context.Context, *pb.DeleteNotificationReq) (*pb.DeleteNotificationResp, error)
+	// Byudjet ogohlantirishlari
+	SetBudgetAlertThresholds(context.Context, *pb.SetBudgetAlertThresholdsReq) (*pb.SetBudgetAlertThresholdsResp, error)
 }
 
 type reportingNotificationImpl struct {
@@ -51,6 +56,24 @@ func (s *reportingNotificationImpl) GetIncome(ctx context.Context, request *pb.G
 	return resp, nil
 }
 
+func (s *reportingNotificationImpl) GetSpendingByTag(ctx context.Context, request *pb.GetSpendingByTagReq) (*pb.GetSpendingByTagResp, error) {
+	resp, err := s.storage.ReportingRepository().GetSpendingByTag(ctx, request)
+	if err != nil {
+		s.logger.Error("Get spending by tag report error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+func (s *reportingNotificationImpl) GetSpendingByCategory(ctx context.Context, request *pb.GetSpendingByCategoryReq) (*pb.GetSpendingByCategoryResp, error) {
+	resp, err := s.storage.ReportingRepository().GetSpendingByCategory(ctx, request)
+	if err != nil {
+		s.logger.Error("Get spending by category report error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
 func (s *reportingNotificationImpl) GetBudgetPerformance(ctx context.Context, request *pb.GetBudgetPerformanceReq) (*pb.GetBudgetPerformanceResp, error) {
 	resp, err := s.storage.ReportingRepository().GetBudgetPerformance(ctx, request)
 	if err != nil {
@@ -69,6 +92,15 @@ func (s *reportingNotificationImpl) GoalProgress(ctx context.Context, request *p
 	return resp, nil
 }
 
+func (s *reportingNotificationImpl) ForecastGoal(ctx context.Context, request *pb.ForecastGoalReq) (*pb.ForecastGoalResp, error) {
+	resp, err := s.storage.ReportingRepository().ForecastGoal(ctx, request)
+	if err != nil {
+		s.logger.Error("Forecast goal error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
 func (s *reportingNotificationImpl) SendNotification(ctx context.Context, request *pb.SendNotificationReq) (*pb.SendNotificationResp, error) {
 	resp, err := s.storage.NotificationRepository().SendNotification(ctx, request)
 	if err!= nil {
@@ -114,3 +146,12 @@ func (s *reportingNotificationImpl) DeleteNotification(ctx context.Context, requ
     }
 	return resp, nil
 }
+
+func (s *reportingNotificationImpl) SetBudgetAlertThresholds(ctx context.Context, request *pb.SetBudgetAlertThresholdsReq) (*pb.SetBudgetAlertThresholdsResp, error) {
+	resp, err := s.storage.UserSettingsRepository().SetBudgetAlertThresholds(ctx, request)
+	if err != nil {
+		s.logger.Error("Set budget alert thresholds error", "error", err)
+		return resp, err
+	}
+	return resp, nil
+}
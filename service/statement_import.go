@@ -0,0 +1,238 @@
+package service
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/pkg/errs"
+	"budgeting-service/pkg/statement"
+	"budgeting-service/storage/mongodb"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// MaxStatementSize bitta import qilinadigan ko'chirmaning eng katta hajmi.
+const MaxStatementSize = 10 << 20
+
+// Import qilingan qator holatlari
+const (
+	ImportRowParsed    = "parsed"
+	ImportRowImported  = "imported"
+	ImportRowDuplicate = "duplicate"
+	ImportRowFailed    = "failed"
+)
+
+// ImportStatement bank ko'chirmasini stream orqali qabul qiladi. Birinchi xabarda
+// hisob, format va sozlamalar, keyingilarida faylning bo'laklari (chunk) keladi.
+// Preview rejimida qatorlar faqat tahlil qilinib qaytariladi. Tranzaksiya id si
+// ko'chirmadagi ma'lumotdan hosil qilinadi, shuning uchun faylni qayta import
+// qilish dublikat yaratmaydi.
+func (s *financeManagementServiceImpl) ImportStatement(stream pb.FinanceManagementService_ImportStatementServer) error {
+	ctx := stream.Context()
+	header, data, err := receiveStatement(stream)
+	if err != nil {
+		s.logger.Error("Receive statement error", "error", err)
+		return err
+	}
+
+	account, err := s.storage.AccountRepository().GetAccount(ctx, &pb.GetAccountReq{Id: header.GetAccountId(), UserId: header.GetUserId()})
+	if err != nil {
+		s.logger.Error("Get account error", "error", err)
+		return err
+	}
+	if account.GetRole() == mongodb.AccountRoleViewer {
+		return errs.PermissionDenied("viewers can not import statements")
+	}
+
+	rows, err := statement.Parse(header.GetFormat(), bytes.NewReader(data), statement.Options{
+		Currency: account.GetCurrency(),
+		CSV:      csvMapping(header.GetCsvMapping()),
+	})
+	if err != nil {
+		s.logger.Error("Parse statement error", "error", err)
+		return errs.InvalidField("chunk", err.Error())
+	}
+
+	resp := &pb.ImportStatementResp{Status: "success"}
+	var imported []importedTransaction
+	seen := map[string]int{}
+	for _, row := range rows {
+		result := importedRow(row, account.GetCurrency())
+		resp.Rows = append(resp.Rows, result)
+		if result.Status == ImportRowFailed {
+			resp.FailedCount++
+			continue
+		}
+		// Kategoriya berilmagan bo'lsa, qaysi qoida qo'llanishi ko'rsatiladi
+		if header.GetCategoryId() == "" {
+			match, err := s.storage.CategoryRuleRepository().TestCategoryRules(ctx, &pb.TestCategoryRulesReq{
+				UserId:       header.GetUserId(),
+				AccountId:    header.GetAccountId(),
+				Description:  result.Description,
+				Counterparty: result.Counterparty,
+				Amount:       result.Amount,
+				Type:         result.Type,
+			})
+			if err != nil {
+				s.logger.Error("Test category rules error", "error", err)
+				return err
+			}
+			result.CategoryRuleId = match.GetRule().GetId()
+		}
+		if header.GetPreview() {
+			continue
+		}
+
+		transaction := &pb.CreateTransactionReq{
+			Id:           importedTransactionId(header.GetAccountId(), row, seen),
+			AccountId:    header.GetAccountId(),
+			UserId:       header.GetUserId(),
+			CategoryId:   header.GetCategoryId(),
+			Amount:       result.Amount,
+			Type:         result.Type,
+			Description:  result.Description,
+			Counterparty: result.Counterparty,
+			Date:         result.Date,
+		}
+		created, err := s.storage.TransactionRepository().CreateTransaction(ctx, transaction)
+		switch {
+		case err == nil:
+			result.Status = ImportRowImported
+			result.TransactionId = transaction.Id
+			resp.ImportedCount++
+			imported = append(imported, importedTransaction{transaction, created.GetCategoryId()})
+			// Qo'lda kiritilgan yoki boshqa ko'chirmadan kelgan o'xshash tranzaksiya
+			duplicateOf, err := s.storage.TransactionRepository().FlagDuplicates(ctx, transaction.Id)
+			if err != nil {
+				s.logger.Error("Flag duplicates error", "error", err)
+			}
+			result.SuspectedDuplicateOf = duplicateOf
+		case errors.Is(err, mongodb.ErrTransactionExists):
+			result.Status = ImportRowDuplicate
+			result.TransactionId = transaction.Id
+			resp.DuplicateCount++
+		default:
+			result.Status = ImportRowFailed
+			result.Error = err.Error()
+			resp.FailedCount++
+		}
+	}
+
+	if len(imported) > 0 {
+		if err := refreshBalance(ctx, s.storage, header.GetUserId(), header.GetAccountId()); err != nil {
+			s.logger.Error("Set balance error", "error", err)
+			return err
+		}
+		for _, item := range imported {
+			if err := checkBudgetAlerts(ctx, s.storage, item.transaction, item.categoryId); err != nil {
+				s.logger.Error("Check budget alerts error", "error", err)
+			}
+		}
+	}
+
+	resp.Message = fmt.Sprintf("%d rows parsed", len(rows))
+	if !header.GetPreview() {
+		resp.Message = fmt.Sprintf("%d imported, %d duplicates, %d failed", resp.ImportedCount, resp.DuplicateCount, resp.FailedCount)
+	}
+	return stream.SendAndClose(resp)
+}
+
+// receiveStatement sozlamalar xabarini va faylning barcha bo'laklarini o'qiydi.
+func receiveStatement(stream pb.FinanceManagementService_ImportStatementServer) (*pb.ImportStatementReq, []byte, error) {
+	header, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		return nil, nil, err
+	}
+	if header.GetFormat() == "" {
+		return nil, nil, errs.InvalidField("format", "is required")
+	}
+
+	var data bytes.Buffer
+	data.Write(header.GetChunk())
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if data.Len()+len(req.GetChunk()) > MaxStatementSize {
+			return nil, nil, errs.InvalidField("chunk", fmt.Sprintf("statement must not exceed %d bytes", MaxStatementSize))
+		}
+		data.Write(req.GetChunk())
+	}
+	if data.Len() == 0 {
+		return nil, nil, errs.InvalidField("chunk", "statement is empty")
+	}
+	return header, data.Bytes(), nil
+}
+
+// importedTransaction yaratilgan tranzaksiya va unga qoida bergan bo'lishi
+// mumkin bo'lgan kategoriya (byudjet ogohlantirishlari uchun).
+type importedTransaction struct {
+	transaction *pb.CreateTransactionReq
+	categoryId  string
+}
+
+// importedRow ko'chirma qatorini javob qatoriga o'giradi. Yaroqsiz qatorlar failed bo'ladi.
+func importedRow(row statement.Row, currency string) *pb.ImportedRow {
+	result := &pb.ImportedRow{
+		Line:         int64(row.Line),
+		Description:  row.Description,
+		Counterparty: row.Counterparty,
+		Reference:    row.Reference,
+		Status:       ImportRowParsed,
+	}
+	switch {
+	case row.Err != nil:
+		result.Error = row.Err.Error()
+	case row.Currency != "" && !strings.EqualFold(row.Currency, currency):
+		result.Error = fmt.Sprintf("currency %s does not match account currency %s", row.Currency, currency)
+	case row.Amount == 0:
+		result.Error = "amount must not be zero"
+	}
+	if result.Error != "" {
+		result.Status = ImportRowFailed
+		return result
+	}
+
+	result.Date = row.Date.Format("2006-01-02 15:04:05")
+	result.Type, result.Amount = mongodb.TransactionTypeIncome, row.Amount
+	if row.Amount < 0 {
+		result.Type, result.Amount = mongodb.TransactionTypeExpense, -row.Amount
+	}
+	return result
+}
+
+// importedTransactionId qator uchun barqaror id hosil qiladi. Bankdagi identifikator
+// bo'lmasa sana, summa va tavsifdan foydalaniladi; bir kunda bir xil bo'lgan
+// qatorlar fayldagi tartib raqami bilan ajratiladi.
+func importedTransactionId(accountId string, row statement.Row, seen map[string]int) string {
+	key := "ref/" + row.Reference
+	if row.Reference == "" {
+		key = fmt.Sprintf("row/%s/%d/%s", row.Date.Format("2006-01-02"), row.Amount, strings.ToLower(row.Description))
+		seen[key]++
+		key = fmt.Sprintf("%s/%d", key, seen[key])
+	}
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte("import/"+accountId+"/"+key)).String()
+}
+
+func csvMapping(mapping *pb.CsvMapping) statement.CSVMapping {
+	return statement.CSVMapping{
+		Date:             mapping.GetDate(),
+		Amount:           mapping.GetAmount(),
+		Debit:            mapping.GetDebit(),
+		Credit:           mapping.GetCredit(),
+		Description:      mapping.GetDescription(),
+		Counterparty:     mapping.GetCounterparty(),
+		Reference:        mapping.GetReference(),
+		DateLayout:       mapping.GetDateLayout(),
+		Delimiter:        mapping.GetDelimiter(),
+		HasHeader:        mapping.GetHasHeader(),
+		DecimalSeparator: mapping.GetDecimalSeparator(),
+	}
+}
@@ -0,0 +1,334 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Umumiy hisobdagi rollar. Egasi - hisobni yaratgan foydalanuvchi,
+// qolganlari taklif orqali qo'shiladi.
+const (
+	AccountRoleOwner  = "owner"
+	AccountRoleEditor = "editor"
+	AccountRoleViewer = "viewer"
+)
+
+// A'zolik holatlari
+const (
+	MemberStatusPending = "pending"
+	MemberStatusActive  = "active"
+	MemberStatusRevoked = "revoked"
+)
+
+var (
+	ErrInvitationNotFound = errs.NotFound("invitation not found")
+	ErrMemberNotFound     = errs.NotFound("account member not found")
+	ErrMemberExists       = errs.AlreadyExists("user is already a member or invited")
+	ErrInvalidMemberRole  = errs.InvalidField("role", "must be editor or viewer")
+)
+
+// roleRank rollarni huquq darajasi bo'yicha tartiblaydi
+var roleRank = map[string]int{
+	AccountRoleViewer: 1,
+	AccountRoleEditor: 2,
+	AccountRoleOwner:  3,
+}
+
+type AccountMemberRepository interface {
+	InviteAccountMember(ctx context.Context, request *pb.InviteAccountMemberReq) (*pb.InviteAccountMemberResp, error)
+	AcceptAccountInvitation(ctx context.Context, request *pb.AcceptAccountInvitationReq) (*pb.AcceptAccountInvitationResp, error)
+	RevokeAccountMember(ctx context.Context, request *pb.RevokeAccountMemberReq) (*pb.RevokeAccountMemberResp, error)
+	GetAccountMembers(ctx context.Context, request *pb.GetAccountMembersReq) (*pb.GetAccountMembersResp, error)
+	GetAccountInvitations(ctx context.Context, request *pb.GetAccountInvitationsReq) (*pb.GetAccountInvitationsResp, error)
+}
+
+type accountMemberRepositoryImpl struct {
+	db   *mongo.Database
+	coll *mongo.Collection
+}
+
+func NewAccountMemberRepository(db *mongo.Database) AccountMemberRepository {
+	return &accountMemberRepositoryImpl{db: db, coll: db.Collection("account_members")}
+}
+
+// InviteAccountMember hisobga yangi a'zo taklif qiladi. Faqat hisob egasi taklif yubora oladi.
+func (repo *accountMemberRepositoryImpl) InviteAccountMember(ctx context.Context, request *pb.InviteAccountMemberReq) (*pb.InviteAccountMemberResp, error) {
+	id, err := repo.invite(ctx, request)
+	if err != nil {
+		return &pb.InviteAccountMemberResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	return &pb.InviteAccountMemberResp{
+		Status:       "success",
+		Message:      "invited account member successfully",
+		InvitationId: id,
+	}, nil
+}
+
+func (repo *accountMemberRepositoryImpl) invite(ctx context.Context, request *pb.InviteAccountMemberReq) (string, error) {
+	if request.Role != AccountRoleEditor && request.Role != AccountRoleViewer {
+		return "", ErrInvalidMemberRole
+	}
+	if _, err := checkAccountRole(ctx, repo.db, request.AccountId, request.UserId, AccountRoleOwner); err != nil {
+		return "", err
+	}
+	if request.MemberId == "" {
+		return "", errs.InvalidField("member_id", "is required")
+	}
+	if request.MemberId == request.UserId {
+		return "", errs.InvalidField("member_id", "can not invite yourself")
+	}
+
+	count, err := repo.coll.CountDocuments(ctx, bson.D{
+		{Key: "account_id", Value: request.AccountId},
+		{Key: "user_id", Value: request.MemberId},
+		{Key: "status", Value: bson.D{{Key: "$in", Value: bson.A{MemberStatusPending, MemberStatusActive}}}},
+	})
+	if err != nil {
+		return "", err
+	}
+	if count > 0 {
+		return "", ErrMemberExists
+	}
+
+	id := uuid.NewString()
+	_, err = repo.coll.InsertOne(ctx, models.AccountMember{
+		ID:        id,
+		AccountId: request.AccountId,
+		UserId:    request.MemberId,
+		Role:      request.Role,
+		Status:    MemberStatusPending,
+		InvitedBy: request.UserId,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// AcceptAccountInvitation taklifni qabul qiladi. Taklifni faqat taklif qilingan foydalanuvchi qabul qiladi.
+func (repo *accountMemberRepositoryImpl) AcceptAccountInvitation(ctx context.Context, request *pb.AcceptAccountInvitationReq) (*pb.AcceptAccountInvitationResp, error) {
+	var invitation models.AccountMember
+	err := repo.coll.FindOne(ctx, bson.D{
+		{Key: "_id", Value: request.InvitationId},
+		{Key: "status", Value: MemberStatusPending},
+	}).Decode(&invitation)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		err = ErrInvitationNotFound
+	} else if err == nil && (request.UserId == "" || invitation.UserId != request.UserId) {
+		err = ErrPermissionDenied
+	}
+	if err != nil {
+		return &pb.AcceptAccountInvitationResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	_, err = repo.coll.UpdateOne(ctx, bson.D{
+		{Key: "_id", Value: invitation.ID},
+		{Key: "status", Value: MemberStatusPending},
+	}, bson.D{{Key: "$set", Value: bson.D{
+		{Key: "status", Value: MemberStatusActive},
+		{Key: "accepted_at", Value: time.Now()},
+	}}})
+	if err != nil {
+		return &pb.AcceptAccountInvitationResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	return &pb.AcceptAccountInvitationResp{
+		Status:  "success",
+		Message: "accepted account invitation successfully",
+	}, nil
+}
+
+// RevokeAccountMember a'zolikni yoki taklifni bekor qiladi. Egasi istalgan a'zoni,
+// a'zo esa faqat o'zini chiqarishi mumkin.
+func (repo *accountMemberRepositoryImpl) RevokeAccountMember(ctx context.Context, request *pb.RevokeAccountMemberReq) (*pb.RevokeAccountMemberResp, error) {
+	err := repo.revoke(ctx, request)
+	if err != nil {
+		return &pb.RevokeAccountMemberResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	return &pb.RevokeAccountMemberResp{
+		Status:  "success",
+		Message: "revoked account member successfully",
+	}, nil
+}
+
+func (repo *accountMemberRepositoryImpl) revoke(ctx context.Context, request *pb.RevokeAccountMemberReq) error {
+	minRole := AccountRoleOwner
+	if request.MemberId == request.UserId {
+		minRole = AccountRoleViewer
+	}
+	_, role, err := findAccountRole(ctx, repo.db, request.AccountId, request.UserId)
+	if err != nil {
+		return err
+	}
+	if roleRank[role] < roleRank[minRole] {
+		return ErrPermissionDenied
+	}
+
+	res, err := repo.coll.UpdateMany(ctx, bson.D{
+		{Key: "account_id", Value: request.AccountId},
+		{Key: "user_id", Value: request.MemberId},
+		{Key: "status", Value: bson.D{{Key: "$in", Value: bson.A{MemberStatusPending, MemberStatusActive}}}},
+	}, bson.D{{Key: "$set", Value: bson.D{
+		{Key: "status", Value: MemberStatusRevoked},
+		{Key: "revoked_at", Value: time.Now()},
+	}}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrMemberNotFound
+	}
+	return nil
+}
+
+// GetAccountMembers hisob egasi va uning faol hamda taklif qilingan a'zolarini qaytaradi.
+func (repo *accountMemberRepositoryImpl) GetAccountMembers(ctx context.Context, request *pb.GetAccountMembersReq) (*pb.GetAccountMembersResp, error) {
+	account, err := checkAccountRole(ctx, repo.db, request.AccountId, request.UserId, AccountRoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	members := []*pb.AccountMember{{
+		AccountId: account.ID,
+		UserId:    account.UserId,
+		Role:      AccountRoleOwner,
+		Status:    MemberStatusActive,
+	}}
+	others, err := repo.find(ctx, bson.D{
+		{Key: "account_id", Value: request.AccountId},
+		{Key: "status", Value: bson.D{{Key: "$in", Value: bson.A{MemberStatusPending, MemberStatusActive}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetAccountMembersResp{Members: append(members, others...)}, nil
+}
+
+// GetAccountInvitations foydalanuvchiga kelgan, hali qabul qilinmagan takliflar.
+func (repo *accountMemberRepositoryImpl) GetAccountInvitations(ctx context.Context, request *pb.GetAccountInvitationsReq) (*pb.GetAccountInvitationsResp, error) {
+	invitations, err := repo.find(ctx, bson.D{
+		{Key: "user_id", Value: request.UserId},
+		{Key: "status", Value: MemberStatusPending},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetAccountInvitationsResp{Invitations: invitations}, nil
+}
+
+func (repo *accountMemberRepositoryImpl) find(ctx context.Context, filter bson.D) ([]*pb.AccountMember, error) {
+	cursor, err := repo.coll.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []models.AccountMember
+	if err := cursor.All(ctx, &members); err != nil {
+		return nil, err
+	}
+
+	var result []*pb.AccountMember
+	for _, member := range members {
+		item := &pb.AccountMember{
+			Id:        member.ID,
+			AccountId: member.AccountId,
+			UserId:    member.UserId,
+			Role:      member.Role,
+			Status:    member.Status,
+			InvitedBy: member.InvitedBy,
+			CreatedAt: member.CreatedAt.Format("2006-01-02 15:04:05"),
+		}
+		if member.AcceptedAt != nil {
+			item.AcceptedAt = member.AcceptedAt.Format("2006-01-02 15:04:05")
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// checkAccountRole foydalanuvchining hisobdagi roli kamida minRole ekanini tekshiradi
+// va hisobni qaytaradi.
+func checkAccountRole(ctx context.Context, db *mongo.Database, accountId, userId, minRole string) (*models.GetAccount, error) {
+	account, role, err := findAccountRole(ctx, db, accountId, userId)
+	if err != nil {
+		return nil, err
+	}
+	if roleRank[role] < roleRank[minRole] {
+		return nil, ErrPermissionDenied
+	}
+	return account, nil
+}
+
+// findAccountRole foydalanuvchining hisobdagi rolini aniqlaydi: hisob egasi bo'lsa owner,
+// aks holda faol a'zolikdagi rol. Hisobga aloqasi bo'lmasa ErrPermissionDenied.
+func findAccountRole(ctx context.Context, db *mongo.Database, accountId, userId string) (*models.GetAccount, string, error) {
+	if userId == "" {
+		return nil, "", ErrPermissionDenied
+	}
+
+	var account models.GetAccount
+	err := db.Collection("accounts").FindOne(ctx, bson.D{
+		{Key: "_id", Value: accountId},
+		{Key: "deleted_at", Value: nil},
+	}).Decode(&account)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, "", ErrAccountNotFound
+	} else if err != nil {
+		return nil, "", err
+	}
+	if account.UserId == userId {
+		return &account, AccountRoleOwner, nil
+	}
+
+	var member models.AccountMember
+	err = db.Collection("account_members").FindOne(ctx, bson.D{
+		{Key: "account_id", Value: accountId},
+		{Key: "user_id", Value: userId},
+		{Key: "status", Value: MemberStatusActive},
+	}).Decode(&member)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, "", ErrPermissionDenied
+	} else if err != nil {
+		return nil, "", err
+	}
+	return &account, member.Role, nil
+}
+
+// accessibleAccountIds foydalanuvchining o'z hisoblari (o'chirilganlari ham) va
+// unga ulashilgan hisoblar ID lari.
+func accessibleAccountIds(ctx context.Context, db *mongo.Database, userId string) (bson.A, error) {
+	own, err := db.Collection("accounts").Distinct(ctx, "_id", bson.D{{Key: "user_id", Value: userId}})
+	if err != nil {
+		return nil, err
+	}
+	shared, err := db.Collection("account_members").Distinct(ctx, "account_id", bson.D{
+		{Key: "user_id", Value: userId},
+		{Key: "status", Value: MemberStatusActive},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := append(bson.A{}, own...)
+	return append(ids, shared...), nil
+}
@@ -0,0 +1,111 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAccountRoleRank(t *testing.T) {
+	assert.Less(t, roleRank[AccountRoleViewer], roleRank[AccountRoleEditor])
+	assert.Less(t, roleRank[AccountRoleEditor], roleRank[AccountRoleOwner])
+	assert.Zero(t, roleRank["unknown"])
+
+	assert.Equal(t, bson.E{Key: "account_id", Value: "acc"}, budgetAccountFilter("acc"))
+	assert.Equal(t, codes.AlreadyExists, status.Code(ErrMemberExists))
+}
+
+func TestSharedAccountRoles(t *testing.T) {
+	db, err := ConnectToMongoDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Client().Disconnect(context.Background())
+	ctx := context.Background()
+
+	owner, editor, viewer := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	accountId := uuid.NewString()
+	_, err = db.Collection("accounts").InsertOne(ctx, bson.D{
+		{Key: "_id", Value: accountId},
+		{Key: "user_id", Value: owner},
+		{Key: "name", Value: "Household"},
+		{Key: "balance", Value: int64(0)},
+		{Key: "currency", Value: "USD"},
+		{Key: "created_at", Value: time.Now()},
+		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	members := NewAccountMemberRepository(db)
+	accounts := NewAccountRepository(db)
+	transactions := NewTransactionRepository(db)
+
+	// Faqat egasi taklif qila oladi
+	_, err = members.InviteAccountMember(ctx, &pb.InviteAccountMemberReq{AccountId: accountId, UserId: editor, MemberId: viewer, Role: AccountRoleViewer})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	invites := map[string]string{}
+	for member, role := range map[string]string{editor: AccountRoleEditor, viewer: AccountRoleViewer} {
+		resp, err := members.InviteAccountMember(ctx, &pb.InviteAccountMemberReq{AccountId: accountId, UserId: owner, MemberId: member, Role: role})
+		assert.NoError(t, err)
+		invites[member] = resp.InvitationId
+	}
+	_, err = members.InviteAccountMember(ctx, &pb.InviteAccountMemberReq{AccountId: accountId, UserId: owner, MemberId: editor, Role: AccountRoleEditor})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// Qabul qilinmaguncha hisob ko'rinmaydi
+	_, err = accounts.GetAccount(ctx, &pb.GetAccountReq{Id: accountId, UserId: viewer})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	pending, err := members.GetAccountInvitations(ctx, &pb.GetAccountInvitationsReq{UserId: viewer})
+	assert.NoError(t, err)
+	assert.Len(t, pending.Invitations, 1)
+
+	_, err = members.AcceptAccountInvitation(ctx, &pb.AcceptAccountInvitationReq{InvitationId: invites[viewer], UserId: editor})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	for member, id := range invites {
+		_, err := members.AcceptAccountInvitation(ctx, &pb.AcceptAccountInvitationReq{InvitationId: id, UserId: member})
+		assert.NoError(t, err)
+	}
+
+	account, err := accounts.GetAccount(ctx, &pb.GetAccountReq{Id: accountId, UserId: viewer})
+	assert.NoError(t, err)
+	assert.Equal(t, AccountRoleViewer, account.Role)
+
+	list, err := members.GetAccountMembers(ctx, &pb.GetAccountMembersReq{AccountId: accountId, UserId: viewer})
+	assert.NoError(t, err)
+	assert.Len(t, list.Members, 3)
+
+	// viewer yoza olmaydi, editor yoza oladi
+	expense := &pb.CreateTransactionReq{AccountId: accountId, Type: TransactionTypeExpense, Amount: 500, Date: "2024-01-01 00:00:00"}
+	expense.UserId = viewer
+	_, err = transactions.CreateTransaction(ctx, expense)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	expense.UserId = editor
+	_, err = transactions.CreateTransaction(ctx, expense)
+	assert.NoError(t, err)
+
+	shared, err := transactions.GetTransactionsList(ctx, &pb.GetTransactionsListReq{UserId: owner, Page: 1, Limit: 10})
+	assert.NoError(t, err)
+	if assert.Len(t, shared.Transactions, 1) {
+		_, err = transactions.DeleteTransaction(ctx, &pb.DeleteTransactionReq{Id: shared.Transactions[0].Id, UserId: viewer})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	}
+
+	// A'zolik bekor qilingach, kirish yopiladi
+	_, err = members.RevokeAccountMember(ctx, &pb.RevokeAccountMemberReq{AccountId: accountId, UserId: viewer, MemberId: editor})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = members.RevokeAccountMember(ctx, &pb.RevokeAccountMemberReq{AccountId: accountId, UserId: owner, MemberId: editor})
+	assert.NoError(t, err)
+	_, err = accounts.GetAccount(ctx, &pb.GetAccountReq{Id: accountId, UserId: editor})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -2,9 +2,8 @@ package mongodb
 
 import (
 	pb "budgeting-service/generated/budgeting"
-	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,11 +20,12 @@ type AccountRepository interface {
 }
 
 type accountRepositoryImpl struct {
+	db   *mongo.Database
 	coll *mongo.Collection
 }
 
 func NewAccountRepository(db *mongo.Database) AccountRepository {
-	return &accountRepositoryImpl{coll: db.Collection("accounts")}
+	return &accountRepositoryImpl{db: db, coll: db.Collection("accounts")}
 }
 
 func (repo *accountRepositoryImpl) CreateAccount(ctx context.Context, account *pb.CreateAccountReq) (*pb.CreateAccountResp, error) {
@@ -64,12 +64,14 @@ func (repo *accountRepositoryImpl) UpdateAccount(ctx context.Context, account *p
 		}},
 	}
 
-	filter := bson.D{
-		{Key: "_id", Value: account.Id},
-		{Key: "deleted_at", Value: nil},
+	if err := findOwned(ctx, repo.coll, account.Id, account.UserId, ErrAccountNotFound, nil); err != nil {
+		return &pb.UpdateAccountResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
 	}
 
-	_, err := repo.coll.UpdateOne(ctx, filter, update)
+	_, err := repo.coll.UpdateOne(ctx, ownedFilter(account.Id, account.UserId), update)
 
 	if err != nil {
 		return &pb.UpdateAccountResp{
@@ -84,26 +86,21 @@ func (repo *accountRepositoryImpl) UpdateAccount(ctx context.Context, account *p
 }
 
 func (repo *accountRepositoryImpl) DeleteAccount(ctx context.Context, request *pb.DeleteAccountReq) (*pb.DeleteAccountResp, error) {
-	filter := bson.D{
-		{Key: "_id", Value: request.Id},
-		{Key: "user_id", Value: request.UserId},
-		{Key: "deleted_at", Value: time.Now()},
-	}
-
-	res, err := repo.coll.UpdateOne(ctx, filter, bson.D{{Key: "$set", Value: bson.D{{Key: "deleted_at", Value: nil}}}})
-
-	if err != nil {
+	if err := findOwned(ctx, repo.coll, request.Id, request.UserId, ErrAccountNotFound, nil); err != nil {
 		return &pb.DeleteAccountResp{
 			Status:  "error",
 			Message: err.Error(),
 		}, err
 	}
 
-	if res.ModifiedCount == 0 {
+	_, err := repo.coll.UpdateOne(ctx, ownedFilter(request.Id, request.UserId), bson.D{
+		{Key: "$set", Value: bson.D{{Key: "deleted_at", Value: time.Now()}}},
+	})
+	if err != nil {
 		return &pb.DeleteAccountResp{
 			Status:  "error",
-			Message: "account not found",
-		}, nil
+			Message: err.Error(),
+		}, err
 	}
 
 	return &pb.DeleteAccountResp{
@@ -113,14 +110,8 @@ func (repo *accountRepositoryImpl) DeleteAccount(ctx context.Context, request *p
 }
 
 func (repo *accountRepositoryImpl) GetAccount(ctx context.Context, request *pb.GetAccountReq) (*pb.GetAccountResp, error) {
-	filter := bson.D{
-		{Key: "_id", Value: request.Id},
-		{Key: "deleted_at", Value: nil},
-	}
-
-	var account models.GetAccount
-	err := repo.coll.FindOne(ctx, filter).Decode(&account)
-
+	// Hisobni egasi ham, unga ulashilgan a'zolar ham ko'ra oladi
+	account, role, err := findAccountRole(ctx, repo.db, request.Id, request.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -132,11 +123,16 @@ func (repo *accountRepositoryImpl) GetAccount(ctx context.Context, request *pb.G
 		Type:     account.Type,
 		Balance:  account.Balance,
 		Currency: account.Currency,
+		Role:     role,
 	}, nil
 }
 
 func (repo *accountRepositoryImpl) GetAccountsList(ctx context.Context, request *pb.GetAccountsListReq) (*pb.GetAccountsListResp, error) {
-	pipeline := createFilters(request)
+	accountIds, err := accessibleAccountIds(ctx, repo.db, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	pipeline := createFilters(request, accountIds)
 
 	countPipeline := append(pipeline, bson.D{{Key: "$count", Value: "totalCount"}})
 	countCursor, err := repo.coll.Aggregate(ctx, countPipeline)
@@ -174,7 +170,7 @@ func (repo *accountRepositoryImpl) GetAccountsList(ctx context.Context, request
 	}
 
 	if len(accounts) == 0 {
-		return nil, fmt.Errorf("account not found")
+		return nil, errs.NotFound("accounts not found")
 	}
 	return &pb.GetAccountsListResp{
 		Limit:      request.Limit,
@@ -184,22 +180,15 @@ func (repo *accountRepositoryImpl) GetAccountsList(ctx context.Context, request
 	}, nil
 }
 
-func createFilters(request *pb.GetAccountsListReq) mongo.Pipeline {
-	pipeline := mongo.Pipeline{}
-	if request.UserId != "" {
-		pipeline = append(pipeline, bson.D{{
-			Key: "$match", Value: bson.D{
-				{Key: "user_id", Value: request.UserId},
-			}},
-		})
+func createFilters(request *pb.GetAccountsListReq, accountIds bson.A) mongo.Pipeline {
+	// Foydalanuvchining o'z hisoblari va unga ulashilgan hisoblar
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: accountIds}}}}}},
 	}
 	if request.Name != "" {
 		pipeline = append(pipeline, bson.D{
 			{Key: "$match", Value: bson.D{
-				{Key: "name", Value: bson.D{
-					{Key: "$regex", Value: ".*" + request.Name + ".*"},
-					{Key: "$options", Value: "i"},
-				}},
+				{Key: "name", Value: containsRegex(request.Name)},
 			}},
 		})
 	}
@@ -215,10 +204,7 @@ func createFilters(request *pb.GetAccountsListReq) mongo.Pipeline {
 	if request.Currency != "" {
 		pipeline = append(pipeline, bson.D{
 			{Key: "$match", Value: bson.D{
-				{Key: "currency", Value: bson.D{
-					{Key: "$regex", Value: ".*" + request.Currency + ".*"},
-					{Key: "$options", Value: "i"},
-				}},
+				{Key: "currency", Value: containsRegex(request.Currency)},
 			}},
 		})
 	}
@@ -4,6 +4,7 @@ import (
 	"budgeting-service/config"
 	pb "budgeting-service/generated/budgeting"
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -33,7 +34,7 @@ func TestCreateAccount(t *testing.T) {
 		UserId:   "test_user_id",
 		Name:     "Test Account",
 		Type:     "CHECKING",
-		Balance:  1000.0,
+		Balance:  100000,
 		Currency: "USD",
 	}
 	resp, err := repo.CreateAccount(context.Background(), account)
@@ -55,8 +56,9 @@ func TestUpdateAccount(t *testing.T) {
 		Id:       "1e891746-d2bb-44d0-bab0-4660ee52d12d",
 		Name:     "Updated Test Account",
 		Type:     "SAVINGS",
-		Balance:  2000.0,
+		Balance:  200000,
 		Currency: "USD",
+		UserId:   "test_user_id",
 	}
 	resp, err := repo.UpdateAccount(context.Background(), account)
 	if err != nil {
@@ -77,13 +79,13 @@ func TestDeleteAccount(t *testing.T) {
 		Id:     "test_account_id",
 		UserId: "test_user_id",
 	})
+	if errors.Is(err, ErrAccountNotFound) {
+		assert.Equal(t, "account not found", resp.Message)
+		return
+	}
 	if err != nil {
 		t.Fatal(err)
 	}
-
-	if assert.Equal(t, "account not found", resp.Message) {
-		return
-	}
 	assert.Equal(t, "success", resp.Status)
 }
 
@@ -114,6 +116,7 @@ func TestGetAccount(t *testing.T) {
 	repo := NewAccountRepository(db)
 	resp, err := repo.GetAccount(context.Background(), &pb.GetAccountReq{
 		Id:     "116ed66a-d57f-4fd1-b2ef-1310b5367008",
+		UserId: "test_user_id",
 	})
 
 	if assert.Nil(t, err) {
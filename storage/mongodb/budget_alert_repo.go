@@ -0,0 +1,169 @@
+package mongodb
+
+import (
+	"budgeting-service/models"
+	"budgeting-service/pkg/money"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const NotificationTypeBudgetAlert = "budget_alert"
+
+type BudgetAlertRepository interface {
+	CheckBudgetAlerts(ctx context.Context, userId, accountId, categoryId string, date time.Time) error
+}
+
+type budgetAlertRepositoryImpl struct {
+	db            *mongo.Database
+	alerts        *mongo.Collection
+	notifications *mongo.Collection
+	budgets       BudgetManagementRepo
+	reporting     ReportingRepository
+	userSettings  UserSettingsRepository
+}
+
+func NewBudgetAlertRepository(db *mongo.Database) BudgetAlertRepository {
+	return &budgetAlertRepositoryImpl{
+		db:            db,
+		alerts:        db.Collection("budget_alerts"),
+		notifications: db.Collection("notifications"),
+		budgets:       NewBudgetManagementRepo(db),
+		reporting:     NewReportingRepository(db),
+		userSettings:  NewUserSettingsRepository(db),
+	}
+}
+
+// CheckBudgetAlerts date sanasiga to'g'ri keladigan kategoriya byudjetlarini tekshiradi
+// va yangi chegaradan o'tilgan bo'lsa, bildirishnoma yaratadi. Har bir chegara
+// byudjet davri uchun faqat bir marta ishlaydi: byudjet har davrda yangi hujjat
+// bo'lgani uchun budget_alerts dagi _id (byudjet + chegara) buni kafolatlaydi.
+// Umumiy hisob byudjetida chegaralar byudjet egasining sozlamalaridan olinadi.
+// Ichki kategoriya xarajati ota kategoriyalar byudjetlariga ham kiradi.
+func (repo *budgetAlertRepositoryImpl) CheckBudgetAlerts(ctx context.Context, userId, accountId, categoryId string, date time.Time) error {
+	tree, err := loadCategoryTree(ctx, repo.db, userId)
+	if err != nil {
+		return err
+	}
+	categoryIds := append([]string{categoryId}, tree.ancestors(categoryId)...)
+	budgets, err := repo.budgets.GetActiveBudgets(ctx, userId, accountId, categoryIds, date)
+	if err != nil {
+		return err
+	}
+
+	for _, budget := range budgets {
+		thresholds, err := repo.userSettings.GetBudgetAlertThresholds(ctx, budget.UserId)
+		if err != nil {
+			return err
+		}
+		if len(thresholds) == 0 {
+			continue
+		}
+		actual, err := repo.reporting.GetBudgetActual(ctx, budget)
+		if err != nil {
+			return err
+		}
+		reached := reachedThresholds(thresholds, budget.Amount, actual)
+		if len(reached) == 0 {
+			continue
+		}
+		if err := repo.notify(ctx, budget, reached, actual); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notify hali yuborilmagan chegaralarni belgilaydi va eng yuqorisi uchun bitta
+// bildirishnoma yaratadi (bir tranzaksiya bir nechta chegaradan o'tishi mumkin).
+func (repo *budgetAlertRepositoryImpl) notify(ctx context.Context, budget models.GetBudget, reached []int32, actual int64) error {
+	err := withTransaction(ctx, repo.db, func(sc mongo.SessionContext) error {
+		ids := make(bson.A, 0, len(reached))
+		for _, threshold := range reached {
+			ids = append(ids, budgetAlertId(budget.ID, threshold))
+		}
+		cursor, err := repo.alerts.Find(sc, bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}})
+		if err != nil {
+			return err
+		}
+		var fired []struct {
+			Threshold int32 `bson:"threshold"`
+		}
+		if err := cursor.All(sc, &fired); err != nil {
+			return err
+		}
+
+		alreadyFired := map[int32]bool{}
+		for _, alert := range fired {
+			alreadyFired[alert.Threshold] = true
+		}
+
+		var highest int32
+		for _, threshold := range reached {
+			if alreadyFired[threshold] {
+				continue
+			}
+			_, err := repo.alerts.InsertOne(sc, bson.D{
+				{Key: "_id", Value: budgetAlertId(budget.ID, threshold)},
+				{Key: "budget_id", Value: budget.ID},
+				{Key: "user_id", Value: budget.UserId},
+				{Key: "threshold", Value: threshold},
+				{Key: "actual", Value: actual},
+				{Key: "created_at", Value: time.Now()},
+			})
+			if err != nil {
+				return err
+			}
+			highest = threshold
+		}
+		if highest == 0 {
+			return nil
+		}
+
+		currency, err := repo.userSettings.GetBaseCurrency(sc, budget.UserId)
+		if err != nil {
+			return err
+		}
+		_, err = repo.notifications.InsertOne(sc, bson.D{
+			{Key: "_id", Value: uuid.NewString()},
+			{Key: "user_id", Value: budget.UserId},
+			{Key: "message", Value: fmt.Sprintf("You have used %d%% of your budget: %s of %s spent",
+				highest, money.Format(actual, currency), money.Format(budget.Amount, currency))},
+			{Key: "type", Value: NotificationTypeBudgetAlert},
+			{Key: "status", Value: "sent"},
+			{Key: "budget_id", Value: budget.ID},
+			{Key: "is_read", Value: false},
+			{Key: "created_at", Value: time.Now()},
+			{Key: "updated_at", Value: time.Now()},
+			{Key: "deleted_at", Value: nil},
+		})
+		return err
+	})
+	// Parallel tekshiruv shu chegarani allaqachon belgilagan
+	if mongo.IsDuplicateKeyError(err) {
+		return nil
+	}
+	return err
+}
+
+// reachedThresholds ishlatilgan summa yetgan chegaralarni qaytaradi.
+func reachedThresholds(thresholds []int32, target, actual int64) []int32 {
+	if target <= 0 {
+		return nil
+	}
+	var reached []int32
+	for _, threshold := range thresholds {
+		if actual*100 >= int64(threshold)*target {
+			reached = append(reached, threshold)
+		}
+	}
+	return reached
+}
+
+func budgetAlertId(budgetId string, threshold int32) string {
+	return fmt.Sprintf("%s/%d", budgetId, threshold)
+}
@@ -0,0 +1,30 @@
+package mongodb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReachedThresholds(t *testing.T) {
+	thresholds := []int32{50, 80, 100, 120}
+
+	assert.Empty(t, reachedThresholds(thresholds, 10000, 4999))
+	assert.Equal(t, []int32{50}, reachedThresholds(thresholds, 10000, 5000))
+	assert.Equal(t, []int32{50, 80, 100}, reachedThresholds(thresholds, 10000, 10000))
+	assert.Equal(t, []int32{50, 80, 100, 120}, reachedThresholds(thresholds, 10000, 15000))
+	assert.Empty(t, reachedThresholds(thresholds, 0, 15000))
+}
+
+func TestNormalizeThresholds(t *testing.T) {
+	thresholds, err := normalizeThresholds([]int32{100, 50, 100, 80})
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{50, 80, 100}, thresholds)
+
+	thresholds, err = normalizeThresholds(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, thresholds)
+
+	_, err = normalizeThresholds([]int32{50, -10})
+	assert.Error(t, err)
+}
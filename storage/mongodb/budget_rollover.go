@@ -0,0 +1,84 @@
+package mongodb
+
+import (
+	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
+	"budgeting-service/pkg/recurrence"
+	"strings"
+	"time"
+)
+
+// Davr tugaganda qoldiqni keyingi davrga o'tkazish rejimlari
+const (
+	CarryOverNone    = "NONE"
+	CarryOverUnspent = "UNSPENT" // faqat ishlatilmagan qoldiq
+	CarryOverAll     = "ALL"     // qoldiq ham, oshib ketgan summa ham
+)
+
+func normalizeCarryOver(mode string) (string, error) {
+	switch mode = strings.ToUpper(mode); mode {
+	case "":
+		return CarryOverNone, nil
+	case CarryOverNone, CarryOverUnspent, CarryOverAll:
+		return mode, nil
+	default:
+		return "", errs.InvalidField("carry_over", "invalid carry over mode: "+mode)
+	}
+}
+
+// carryForward davr oxirida keyingi davrga o'tadigan summani hisoblaydi.
+// Manfiy qiymat keyingi davr byudjetini kamaytiradi.
+func carryForward(mode string, target, actual int64) int64 {
+	leftover := target - actual
+	switch strings.ToUpper(mode) {
+	case CarryOverUnspent:
+		if leftover < 0 {
+			return 0
+		}
+		return leftover
+	case CarryOverAll:
+		return leftover
+	default:
+		return 0
+	}
+}
+
+// budgetAnchor byudjet davrlari hisoblanadigan sana: zanjirdagi birinchi davr
+// boshlanishi. anchor_date bo'lmagan eski byudjetlar o'z boshlanishidan hisoblanadi.
+func budgetAnchor(budget models.GetBudget) time.Time {
+	if budget.AnchorDate.IsZero() {
+		return budget.StartDate
+	}
+	return budget.AnchorDate
+}
+
+// nextBudgetPeriod byudjetning keyingi davri sanalarini qaytaradi. Davr boshlanishi
+// zanjir boshidan period bo'yicha hisoblanadi, shuning uchun oy oxiriga tushirilgan
+// kun keyingi oylarda tiklanadi (31-yanvar -> 29-fevral -> 31-mart). Tugash sanasi
+// joriy davrdagi kabi keyingi davr boshidan oldin qoladi (masalan,
+// 01-01 00:00:00 – 01-31 23:59:59 dan 02-01 00:00:00 – 02-29 23:59:59 ga).
+func nextBudgetPeriod(budget models.GetBudget) (time.Time, time.Time, error) {
+	schedule := recurrence.Schedule{Frequency: budget.Period, Interval: 1, Start: budgetAnchor(budget)}
+	if err := schedule.Validate(); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	start, _ := schedule.Next(budget.StartDate)
+	after, _ := schedule.Next(start)
+	gap := start.Sub(budget.EndDate)
+	if gap < 0 {
+		gap = 0
+	}
+	return start, after.Add(-gap), nil
+}
+
+// nextBudgetAmount yangi davr byudjeti: asosiy summa va o'tkazilgan qoldiq.
+func nextBudgetAmount(budget models.GetBudget, carried int64) int64 {
+	base := budget.BaseAmount
+	if base == 0 {
+		base = budget.Amount - budget.CarriedAmount
+	}
+	if amount := base + carried; amount > 0 {
+		return amount
+	}
+	return 0
+}
@@ -0,0 +1,62 @@
+package mongodb
+
+import (
+	"budgeting-service/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCarryForward(t *testing.T) {
+	assert.Equal(t, int64(0), carryForward(CarryOverNone, 1000, 600))
+	assert.Equal(t, int64(400), carryForward(CarryOverUnspent, 1000, 600))
+	assert.Equal(t, int64(0), carryForward(CarryOverUnspent, 1000, 1200))
+	assert.Equal(t, int64(-200), carryForward(CarryOverAll, 1000, 1200))
+}
+
+func TestNextBudgetPeriod(t *testing.T) {
+	budget := models.GetBudget{
+		Period:    "MONTHLY",
+		StartDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2024, 1, 31, 23, 59, 59, 0, time.UTC),
+	}
+
+	start, end, err := nextBudgetPeriod(budget)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2024, 2, 29, 23, 59, 59, 0, time.UTC), end)
+
+	// Oy oxiri zanjir boshidan hisoblanadi: 29-fevraldan keyin 31-mart
+	budget = models.GetBudget{
+		Period:     "MONTHLY",
+		StartDate:  time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		EndDate:    time.Date(2024, 3, 30, 23, 59, 59, 0, time.UTC),
+		AnchorDate: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+	start, end, err = nextBudgetPeriod(budget)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2024, 4, 29, 23, 59, 59, 0, time.UTC), end)
+
+	budget.Period = "QUARTERLY"
+	_, _, err = nextBudgetPeriod(budget)
+	assert.Error(t, err)
+}
+
+func TestNextBudgetAmount(t *testing.T) {
+	// 1000 asosiy + 300 o'tkazilgan qoldiq
+	budget := models.GetBudget{Amount: 1300, BaseAmount: 1000, CarriedAmount: 300}
+
+	assert.Equal(t, int64(1000), nextBudgetAmount(budget, 0))
+	assert.Equal(t, int64(1250), nextBudgetAmount(budget, 250))
+	assert.Equal(t, int64(0), nextBudgetAmount(budget, -1500))
+
+	// base_amount maydoni bo'lmagan eski byudjet
+	assert.Equal(t, int64(500), nextBudgetAmount(models.GetBudget{Amount: 500}, 0))
+}
+
+func TestSpendingTrend(t *testing.T) {
+	assert.Equal(t, 0.0, spendingTrend(500, nil))
+	assert.Equal(t, 25.0, spendingTrend(500, []models.BudgetHistory{{Actual: 400}, {Actual: 100}}))
+}
@@ -3,13 +3,16 @@ package mongodb
 import (
 	pb "budgeting-service/generated/budgeting"
 	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
 	"context"
-	"fmt"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type BudgetManagementRepo interface {
@@ -18,33 +21,74 @@ type BudgetManagementRepo interface {
 	DeleteBudget(ctx context.Context, request *pb.DeleteBudgetReq) (*pb.DeleteBudgetResp, error)
 	GetBudget(ctx context.Context, request *pb.GetBudgetReq) (*pb.GetBudgetResp, error)
 	GetBudgetsList(ctx context.Context, request *pb.GetBudgetsReq) (*pb.GetBudgetsResp, error)
+	// Davrni yangilash (rollover)
+	GetExpiredBudgets(ctx context.Context, now time.Time) ([]models.GetBudget, error)
+	RolloverBudget(ctx context.Context, budget models.GetBudget, actual int64) (*models.GetBudget, error)
+	GetBudgetHistory(ctx context.Context, budget models.GetBudget, limit int64) ([]models.BudgetHistory, error)
+	GetActiveBudgets(ctx context.Context, userId, accountId string, categoryIds []string, date time.Time) ([]models.GetBudget, error)
+	CountCategoryBudgets(ctx context.Context, userId, categoryId string) (int64, error)
 }
 
 type budgetManagementRepoImpl struct {
-	coll *mongo.Collection
+	db      *mongo.Database
+	coll    *mongo.Collection
+	history *mongo.Collection
 }
 
 func NewBudgetManagementRepo(db *mongo.Database) BudgetManagementRepo {
-	return &budgetManagementRepoImpl{coll: db.Collection("budgets")}
+	return &budgetManagementRepoImpl{
+		db:      db,
+		coll:    db.Collection("budgets"),
+		history: db.Collection("budget_history"),
+	}
 }
 
 func (repo *budgetManagementRepoImpl) CreateBudget(ctx context.Context, budget *pb.CreateBudgetReq) (*pb.CreateBudgetResp, error) {
-	sdate, err := time.Parse("2006-01-02 15:04:05", budget.StartDate)
+	sdate, err := parseDate("start_date", "2006-01-02 15:04:05", budget.StartDate)
 	if err != nil {
 		return nil, err
 	}
-	edate, err := time.Parse("2006-01-02 15:04:05", budget.EndDate)
+	edate, err := parseDate("end_date", "2006-01-02 15:04:05", budget.EndDate)
 	if err != nil {
 		return nil, err
 	}
+	carryOver, err := normalizeCarryOver(budget.CarryOver)
+	if err != nil {
+		return nil, err
+	}
+	if budget.CategoryId != "" {
+		err := findOwned(ctx, repo.db.Collection("categories"), budget.CategoryId, budget.UserId, ErrCategoryNotFound, nil)
+		if err != nil {
+			return &pb.CreateBudgetResp{
+				Status:  "error",
+				Message: err.Error(),
+			}, err
+		}
+	}
+	// Umumiy hisob byudjetini faqat editor va egasi yarata oladi
+	if budget.AccountId != "" {
+		if _, err := checkAccountRole(ctx, repo.db, budget.AccountId, budget.UserId, AccountRoleEditor); err != nil {
+			return &pb.CreateBudgetResp{
+				Status:  "error",
+				Message: err.Error(),
+			}, err
+		}
+	}
 	_, err = repo.coll.InsertOne(ctx, bson.D{
 		{Key: "_id", Value: uuid.NewString()},
 		{Key: "user_id", Value: budget.UserId},
 		{Key: "category_id", Value: budget.CategoryId},
 		{Key: "amount", Value: budget.Amount},
-		{Key: "period", Value: budget.Period},
+		{Key: "base_amount", Value: budget.Amount},
+		{Key: "period", Value: strings.ToUpper(budget.Period)},
 		{Key: "start_date", Value: sdate},
 		{Key: "end_date", Value: edate},
+		{Key: "anchor_date", Value: sdate},
+		{Key: "auto_renew", Value: budget.AutoRenew},
+		{Key: "carry_over", Value: carryOver},
+		{Key: "carried_amount", Value: int64(0)},
+		{Key: "previous_budget_id", Value: ""},
+		{Key: "account_id", Value: budget.AccountId},
 		{Key: "created_at", Value: time.Now()},
 		{Key: "updated_at", Value: time.Now()},
 		{Key: "deleted_at", Value: nil},
@@ -63,44 +107,62 @@ func (repo *budgetManagementRepoImpl) CreateBudget(ctx context.Context, budget *
 }
 
 func (repo *budgetManagementRepoImpl) GetBudget(ctx context.Context, req *pb.GetBudgetReq) (*pb.GetBudgetResp, error) {
-	filter := bson.D{
-		{Key: "_id", Value: req.Id},
-		{Key: "deleted_at", Value: nil},
-	}
-
-	var budget models.GetBudget
-	err := repo.coll.FindOne(ctx, filter).Decode(&budget)
-	if err == mongo.ErrNoDocuments {
-		return nil, fmt.Errorf("budget not found")
-	} else if err != nil {
+	budget, err := repo.findBudget(ctx, req.Id, req.UserId, AccountRoleViewer)
+	if err != nil {
 		return nil, err
 	}
 
 	return &pb.GetBudgetResp{
-		Id:         budget.ID,
-		UserId:     budget.UserId,
-		CategoryId: budget.CategoryId,
-		Amount:     budget.Amount,
-		Period:     budget.Period,
-		StartDate:  budget.StartDate.Format("2006-01-02 15:04:05"),
-		EndDate:    budget.EndDate.Format("2006-01-02 15:04:05"),
+		Id:               budget.ID,
+		UserId:           budget.UserId,
+		CategoryId:       budget.CategoryId,
+		Amount:           budget.Amount,
+		Period:           budget.Period,
+		StartDate:        budget.StartDate.Format("2006-01-02 15:04:05"),
+		EndDate:          budget.EndDate.Format("2006-01-02 15:04:05"),
+		AutoRenew:        budget.AutoRenew,
+		CarryOver:        budget.CarryOver,
+		CarriedAmount:    budget.CarriedAmount,
+		PreviousBudgetId: budget.PreviousBudgetId,
+		AccountId:        budget.AccountId,
 	}, nil
 }
 
 func (repo *budgetManagementRepoImpl) UpdateBudget(ctx context.Context, budget *pb.UpdateBudgetReq) (*pb.UpdateBudgetResp, error) {
-	filter := bson.D{
-		{Key: "_id", Value: budget.Id},
-		{Key: "deleted_at", Value: nil},
+	sdate, err := parseDate("start_date", "2006-01-02 15:04:05", budget.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	edate, err := parseDate("end_date", "2006-01-02 15:04:05", budget.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	carryOver, err := normalizeCarryOver(budget.CarryOver)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := repo.findBudget(ctx, budget.Id, budget.UserId, AccountRoleEditor); err != nil {
+		return &pb.UpdateBudgetResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
 	}
 
-	res, err := repo.coll.UpdateOne(ctx, filter, bson.D{
-		{Key: "$set", Value: bson.D{
+	// base_amount - o'tgan davrdan o'tkazilgan qoldiqsiz summa, keyingi davrlar shundan boshlanadi
+	_, err = repo.coll.UpdateOne(ctx, bson.D{{Key: "_id", Value: budget.Id}}, mongo.Pipeline{
+		bson.D{{Key: "$set", Value: bson.D{
 			{Key: "amount", Value: budget.Amount},
-			{Key: "period", Value: budget.Period},
-			{Key: "start_date", Value: budget.StartDate},
-			{Key: "end_date", Value: budget.EndDate},
+			{Key: "base_amount", Value: bson.D{{Key: "$subtract", Value: bson.A{
+				budget.Amount, bson.D{{Key: "$ifNull", Value: bson.A{"$carried_amount", int64(0)}}},
+			}}}},
+			{Key: "period", Value: strings.ToUpper(budget.Period)},
+			{Key: "start_date", Value: sdate},
+			{Key: "end_date", Value: edate},
+			{Key: "anchor_date", Value: sdate},
+			{Key: "auto_renew", Value: budget.AutoRenew},
+			{Key: "carry_over", Value: carryOver},
 			{Key: "updated_at", Value: time.Now()},
-		}},
+		}}},
 	})
 
 	if err != nil {
@@ -110,13 +172,6 @@ func (repo *budgetManagementRepoImpl) UpdateBudget(ctx context.Context, budget *
 		}, err
 	}
 
-	if res.MatchedCount == 0 {
-		return &pb.UpdateBudgetResp{
-			Status:  "error",
-			Message: "budget not found",
-		}, fmt.Errorf("budget not found")
-	}
-
 	return &pb.UpdateBudgetResp{
 		Status:  "success",
 		Message: "budget updated successfully",
@@ -124,12 +179,14 @@ func (repo *budgetManagementRepoImpl) UpdateBudget(ctx context.Context, budget *
 }
 
 func (repo *budgetManagementRepoImpl) DeleteBudget(ctx context.Context, req *pb.DeleteBudgetReq) (*pb.DeleteBudgetResp, error) {
-	filter := bson.D{
-		{Key: "_id", Value: req.Id},
-		{Key: "deleted_at", Value: nil},
+	if _, err := repo.findBudget(ctx, req.Id, req.UserId, AccountRoleEditor); err != nil {
+		return &pb.DeleteBudgetResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
 	}
 
-	res, err := repo.coll.UpdateOne(ctx, filter, bson.D{
+	_, err := repo.coll.UpdateOne(ctx, bson.D{{Key: "_id", Value: req.Id}}, bson.D{
 		{Key: "$set", Value: bson.D{{Key: "deleted_at", Value: time.Now()}}},
 	})
 
@@ -140,13 +197,6 @@ func (repo *budgetManagementRepoImpl) DeleteBudget(ctx context.Context, req *pb.
 		}, err
 	}
 
-	if res.MatchedCount == 0 {
-		return &pb.DeleteBudgetResp{
-			Status:  "error",
-			Message: "budget not found",
-		}, fmt.Errorf("budget not found")
-	}
-
 	return &pb.DeleteBudgetResp{
 		Status:  "success",
 		Message: "deleted budget successfully",
@@ -154,69 +204,286 @@ func (repo *budgetManagementRepoImpl) DeleteBudget(ctx context.Context, req *pb.
 }
 
 func (repo *budgetManagementRepoImpl) GetBudgetsList(ctx context.Context, budget *pb.GetBudgetsReq) (*pb.GetBudgetsResp, error) {
-	pipeline := createBudgetFilters(budget)
-	// Hujjatlarni sanash uchun `pipeline` ni `Aggregate` bilan ishlating
-	countPipeline := append(pipeline, bson.D{{Key: "$count", Value: "totalCount"}})
-	countCursor, err := repo.coll.Aggregate(ctx, countPipeline)
+	accountIds, err := accessibleAccountIds(ctx, repo.db, budget.UserId)
 	if err != nil {
 		return nil, err
 	}
-	var countResult []bson.M
-	if err = countCursor.All(ctx, &countResult); err != nil {
-		return nil, err
-	}
+	pipeline := createBudgetFilters(budget, accountIds)
+	page := listPage{field: "created_at", page: budget.Page, limit: budget.Limit, token: budget.PageToken}
+
 	totalCount := int32(0)
-	if len(countResult) > 0 {
-		totalCount = countResult[0]["totalCount"].(int32)
+	if !page.cursorMode() {
+		// Hujjatlarni sanash uchun `pipeline` ni `Aggregate` bilan ishlating
+		countPipeline := append(mongo.Pipeline{}, pipeline...)
+		countPipeline = append(countPipeline, bson.D{{Key: "$count", Value: "totalCount"}})
+		countCursor, err := repo.coll.Aggregate(ctx, countPipeline)
+		if err != nil {
+			return nil, err
+		}
+		var countResult []bson.M
+		if err = countCursor.All(ctx, &countResult); err != nil {
+			return nil, err
+		}
+		if len(countResult) > 0 {
+			totalCount = countResult[0]["totalCount"].(int32)
+		}
 	}
 
-	pipeline = append(pipeline, bson.D{{Key: "$skip", Value: (budget.Page - 1) * budget.Limit}})
-	pipeline = append(pipeline, bson.D{{Key: "$limit", Value: budget.Limit}})
+	stages, err := page.stages()
+	if err != nil {
+		return nil, err
+	}
+	pipeline = append(pipeline, stages...)
 
 	cursor, err := repo.coll.Aggregate(ctx, pipeline)
 	if err != nil {
 		return nil, err
 	}
+	var items []models.GetBudget
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, err
+	}
+	n, more := page.trim(len(items))
+
 	var budgets []*pb.Budget
-	for cursor.Next(ctx) {
-		var budget models.GetBudget
-		err := cursor.Decode(&budget)
-		if err != nil {
-			return nil, err
-		}
+	for _, budget := range items[:n] {
 		budgets = append(budgets, &pb.Budget{
-			Id:         budget.ID,
-			UserId:     budget.UserId,
-			CategoryId: budget.CategoryId,
-			Amount:     budget.Amount,
-			Period:     budget.Period,
-			StartDate:  budget.StartDate.Format("2006-01-02 15:04:05"),
-			EndDate:    budget.EndDate.Format("2006-01-02 15:04:05"),
+			Id:               budget.ID,
+			UserId:           budget.UserId,
+			CategoryId:       budget.CategoryId,
+			Amount:           budget.Amount,
+			Period:           budget.Period,
+			StartDate:        budget.StartDate.Format("2006-01-02 15:04:05"),
+			EndDate:          budget.EndDate.Format("2006-01-02 15:04:05"),
+			AutoRenew:        budget.AutoRenew,
+			CarryOver:        budget.CarryOver,
+			CarriedAmount:    budget.CarriedAmount,
+			PreviousBudgetId: budget.PreviousBudgetId,
+			AccountId:        budget.AccountId,
 		})
 	}
-	if err := cursor.Close(ctx); err != nil {
-		return nil, err
-	}
 	if len(budgets) == 0 {
-		return nil, fmt.Errorf("budgets not found")
+		return nil, errs.NotFound("budgets not found")
 	}
 
-	return &pb.GetBudgetsResp{
+	resp := &pb.GetBudgetsResp{
 		Budgets:    budgets,
 		TotalCount: int64(totalCount),
-	}, nil
+	}
+	if more {
+		last := items[n-1]
+		resp.NextPageToken = page.nextToken(last.CreatedAt.UnixMilli(), last.ID)
+	}
+	return resp, nil
 }
 
-func createBudgetFilters(request *pb.GetBudgetsReq) mongo.Pipeline {
-	var pipeline mongo.Pipeline
+// GetExpiredBudgets davri tugagan, hali yangilanmagan va avtomatik yangilanadigan byudjetlarni qaytaradi.
+func (repo *budgetManagementRepoImpl) GetExpiredBudgets(ctx context.Context, now time.Time) ([]models.GetBudget, error) {
+	cursor, err := repo.coll.Find(ctx, bson.D{
+		{Key: "auto_renew", Value: true},
+		{Key: "end_date", Value: bson.D{{Key: "$lt", Value: now}}},
+		{Key: "next_budget_id", Value: bson.D{{Key: "$in", Value: bson.A{nil, ""}}}},
+		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	// Foydalanuvchi ID bo'yicha filter
-	if request.UserId != "" {
-		pipeline = append(pipeline, bson.D{
-			{Key: "$match", Value: bson.D{
-				{Key: "user_id", Value: request.UserId},
-			}},
+	var budgets []models.GetBudget
+	if err := cursor.All(ctx, &budgets); err != nil {
+		return nil, err
+	}
+	return budgets, nil
+}
+
+// RolloverBudget tugagan davrni yopadi: keyingi davr byudjetini yaratadi, tarixga
+// yozadi va eski byudjetni yangisiga bog'laydi. Hammasi bitta tranzaksiyada bajariladi.
+// Byudjet allaqachon yangilangan bo'lsa, nil qaytadi.
+func (repo *budgetManagementRepoImpl) RolloverBudget(ctx context.Context, budget models.GetBudget, actual int64) (*models.GetBudget, error) {
+	start, end, err := nextBudgetPeriod(budget)
+	if err != nil {
+		return nil, err
+	}
+	carried := carryForward(budget.CarryOver, budget.Amount, actual)
+
+	next := models.GetBudget{
+		ID:               uuid.NewString(),
+		UserId:           budget.UserId,
+		CategoryId:       budget.CategoryId,
+		Amount:           nextBudgetAmount(budget, carried),
+		BaseAmount:       nextBudgetAmount(budget, 0),
+		Period:           budget.Period,
+		StartDate:        start,
+		EndDate:          end,
+		AnchorDate:       budgetAnchor(budget),
+		AutoRenew:        budget.AutoRenew,
+		CarryOver:        budget.CarryOver,
+		CarriedAmount:    carried,
+		PreviousBudgetId: budget.ID,
+		AccountId:        budget.AccountId,
+	}
+
+	renewed := false
+	err = withTransaction(ctx, repo.db, func(sc mongo.SessionContext) error {
+		res, err := repo.coll.UpdateOne(sc, bson.D{
+			{Key: "_id", Value: budget.ID},
+			{Key: "next_budget_id", Value: bson.D{{Key: "$in", Value: bson.A{nil, ""}}}},
+		}, bson.D{{Key: "$set", Value: bson.D{
+			{Key: "next_budget_id", Value: next.ID},
+			{Key: "updated_at", Value: time.Now()},
+		}}})
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount == 0 {
+			return nil
+		}
+
+		_, err = repo.coll.InsertOne(sc, bson.D{
+			{Key: "_id", Value: next.ID},
+			{Key: "user_id", Value: next.UserId},
+			{Key: "category_id", Value: next.CategoryId},
+			{Key: "amount", Value: next.Amount},
+			{Key: "base_amount", Value: next.BaseAmount},
+			{Key: "period", Value: next.Period},
+			{Key: "start_date", Value: next.StartDate},
+			{Key: "end_date", Value: next.EndDate},
+			{Key: "anchor_date", Value: next.AnchorDate},
+			{Key: "auto_renew", Value: next.AutoRenew},
+			{Key: "carry_over", Value: next.CarryOver},
+			{Key: "carried_amount", Value: next.CarriedAmount},
+			{Key: "previous_budget_id", Value: next.PreviousBudgetId},
+			{Key: "account_id", Value: next.AccountId},
+			{Key: "created_at", Value: time.Now()},
+			{Key: "updated_at", Value: time.Now()},
+			{Key: "deleted_at", Value: nil},
 		})
+		if err != nil {
+			return err
+		}
+
+		_, err = repo.history.InsertOne(sc, models.BudgetHistory{
+			ID:             uuid.NewString(),
+			BudgetId:       budget.ID,
+			NextBudgetId:   next.ID,
+			UserId:         budget.UserId,
+			CategoryId:     budget.CategoryId,
+			Period:         budget.Period,
+			StartDate:      budget.StartDate,
+			EndDate:        budget.EndDate,
+			Target:         budget.Amount,
+			Actual:         actual,
+			CarriedForward: carried,
+			ClosedAt:       time.Now(),
+			AccountId:      budget.AccountId,
+		})
+		if err != nil {
+			return err
+		}
+		renewed = true
+		return nil
+	})
+	if err != nil || !renewed {
+		return nil, err
+	}
+	return &next, nil
+}
+
+// GetBudgetHistory shu foydalanuvchi va kategoriyaning budget dan oldingi yopilgan
+// davrlarini, eng yangisidan boshlab qaytaradi.
+func (repo *budgetManagementRepoImpl) GetBudgetHistory(ctx context.Context, budget models.GetBudget, limit int64) ([]models.BudgetHistory, error) {
+	cursor, err := repo.history.Find(ctx, bson.D{
+		{Key: "user_id", Value: budget.UserId},
+		{Key: "category_id", Value: budget.CategoryId},
+		{Key: "period", Value: budget.Period},
+		budgetAccountFilter(budget.AccountId),
+		{Key: "end_date", Value: bson.D{{Key: "$lt", Value: budget.StartDate}}},
+	}, options.Find().SetSort(bson.D{{Key: "end_date", Value: -1}}).SetLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	var history []models.BudgetHistory
+	if err := cursor.All(ctx, &history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// GetActiveBudgets kategoriyalarning date sanasi tushadigan davrdagi byudjetlarini qaytaradi:
+// foydalanuvchining shaxsiy byudjetlari va accountId hisobiga bog'langan byudjetlar.
+func (repo *budgetManagementRepoImpl) GetActiveBudgets(ctx context.Context, userId, accountId string, categoryIds []string, date time.Time) ([]models.GetBudget, error) {
+	cursor, err := repo.coll.Find(ctx, bson.D{
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "user_id", Value: userId}, budgetAccountFilter("")},
+			bson.D{{Key: "account_id", Value: accountId}},
+		}},
+		{Key: "category_id", Value: bson.D{{Key: "$in", Value: categoryIds}}},
+		{Key: "start_date", Value: bson.D{{Key: "$lte", Value: date}}},
+		{Key: "end_date", Value: bson.D{{Key: "$gte", Value: date}}},
+		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var budgets []models.GetBudget
+	if err := cursor.All(ctx, &budgets); err != nil {
+		return nil, err
+	}
+	return budgets, nil
+}
+
+// CountCategoryBudgets foydalanuvchining categoryId ga bog'langan o'chirilmagan byudjetlari soni.
+func (repo *budgetManagementRepoImpl) CountCategoryBudgets(ctx context.Context, userId, categoryId string) (int64, error) {
+	return repo.coll.CountDocuments(ctx, bson.D{
+		{Key: "user_id", Value: userId},
+		{Key: "category_id", Value: categoryId},
+		{Key: "deleted_at", Value: nil},
+	})
+}
+
+// findBudget byudjetni topadi va unga kirish huquqini tekshiradi. Hisobga bog'langan
+// byudjetda foydalanuvchining hisobdagi roli kamida minRole bo'lishi kerak,
+// shaxsiy byudjetni esa faqat egasi ishlata oladi.
+func (repo *budgetManagementRepoImpl) findBudget(ctx context.Context, id, userId, minRole string) (*models.GetBudget, error) {
+	var budget models.GetBudget
+	err := repo.coll.FindOne(ctx, bson.D{
+		{Key: "_id", Value: id},
+		{Key: "deleted_at", Value: nil},
+	}).Decode(&budget)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrBudgetNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if budget.AccountId != "" {
+		if _, err := checkAccountRole(ctx, repo.db, budget.AccountId, userId, minRole); err != nil {
+			return nil, err
+		}
+	} else if userId == "" || budget.UserId != userId {
+		return nil, ErrPermissionDenied
+	}
+	return &budget, nil
+}
+
+// budgetAccountFilter byudjet hisobi bo'yicha filter: accountId bo'sh bo'lsa
+// hisobga bog'lanmagan shaxsiy byudjetlar.
+func budgetAccountFilter(accountId string) bson.E {
+	if accountId == "" {
+		return bson.E{Key: "account_id", Value: bson.D{{Key: "$in", Value: bson.A{nil, ""}}}}
+	}
+	return bson.E{Key: "account_id", Value: accountId}
+}
+
+func createBudgetFilters(request *pb.GetBudgetsReq, accountIds bson.A) mongo.Pipeline {
+	// Foydalanuvchining shaxsiy byudjetlari va unga ochiq hisoblar byudjetlari
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: "user_id", Value: request.UserId}, budgetAccountFilter("")},
+			bson.D{{Key: "account_id", Value: bson.D{{Key: "$in", Value: accountIds}}}},
+		}}}}},
 	}
 
 	// Kategoriya nomi bo'yicha filter
@@ -234,10 +501,7 @@ func createBudgetFilters(request *pb.GetBudgetsReq) mongo.Pipeline {
 		})
 		pipeline = append(pipeline, bson.D{
 			{Key: "$match", Value: bson.D{
-				{Key: "category.name", Value: bson.D{
-					{Key: "$regex", Value: ".*" + request.CategoreName + ".*"},
-					{Key: "$options", Value: "i"},
-				}},
+				{Key: "category.name", Value: containsRegex(request.CategoreName)},
 			}},
 		})
 	}
@@ -257,10 +521,7 @@ func createBudgetFilters(request *pb.GetBudgetsReq) mongo.Pipeline {
 	if request.Period != "" {
 		pipeline = append(pipeline, bson.D{
 			{Key: "$match", Value: bson.D{
-				{Key: "period", Value: bson.D{
-					{Key: "$regex", Value: ".*" + request.Period + ".*"},
-					{Key: "$options", Value: "i"},
-				}},
+				{Key: "period", Value: containsRegex(request.Period)},
 			}},
 		})
 	}
@@ -42,6 +42,7 @@ func TestGetBudget(t *testing.T) {
     repo := NewBudgetManagementRepo(db)
     req := &pb.GetBudgetReq{
         Id: "b2828dd7-9460-448e-bd43-c754ad775c01",
+        UserId: "test_user_id",
     }
 
     resp, err := repo.GetBudget(context.Background(), req)
@@ -64,6 +65,7 @@ func TestUpdateBudget(t *testing.T) {
         Period:     "MONTHLY",
         StartDate:  time.Now().Format("2006-01-02 15:04:05"),
         EndDate:    time.Now().Add(time.Hour * 24 * 7).Format("2006-01-02 15:04:05"),
+        UserId: "test_user_id",
 	}
 	resp, err := repo.UpdateBudget(context.Background(), budget)
 	assert.NoError(t, err)
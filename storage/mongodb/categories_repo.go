@@ -3,8 +3,10 @@ package mongodb
 import (
 	pb "budgeting-service/generated/budgeting"
 	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
 	"context"
-	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,26 +20,65 @@ type CategoryRepository interface {
 	DeleteCategory(ctx context.Context, request *pb.DeleteCategoryReq) (*pb.DeleteCategoryResp, error)
 	GetCategory(ctx context.Context, request *pb.GetCategoryReq) (*pb.GetCategoryResp, error)
 	GetCategoriesList(ctx context.Context, request *pb.GetCategoriesReq) (*pb.GetCategoriesResp, error)
+	SuggestCategory(ctx context.Context, request *pb.SuggestCategoryReq) (*pb.SuggestCategoryResp, error)
+	MoveCategory(ctx context.Context, request *pb.MoveCategoryReq) (*pb.MoveCategoryResp, error)
+	MergeCategories(ctx context.Context, request *pb.MergeCategoriesReq) (*pb.MergeCategoriesResp, error)
 }
 
 type categoryRepositoryImpl struct {
-	coll *mongo.Collection
+	db           *mongo.Database
+	coll         *mongo.Collection
+	transactions TransactionRepository
+	budgets      BudgetManagementRepo
 }
 
 func NewCategoryRepository(db *mongo.Database) CategoryRepository {
-	return &categoryRepositoryImpl{coll: db.Collection("categories")}
+	return &categoryRepositoryImpl{
+		db:           db,
+		coll:         db.Collection("categories"),
+		transactions: NewTransactionRepository(db),
+		budgets:      NewBudgetManagementRepo(db),
+	}
 }
 
 func (ropo *categoryRepositoryImpl) CreateCategory(ctx context.Context, category *pb.CreateCategoryReq) (*pb.CreateCategoryResp, error) {
-	_, err := ropo.coll.InsertOne(ctx, bson.D{
-		{Key: "_id", Value: uuid.NewString()},
+	if err := seedDefaultCategories(ctx, ropo.db, category.UserId); err != nil {
+		return &pb.CreateCategoryResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	id := uuid.NewString()
+	doc := bson.D{
+		{Key: "_id", Value: id},
 		{Key: "user_id", Value: category.UserId},
 		{Key: "name", Value: category.Name},
 		{Key: "type", Value: category.Type},
-		{Key: "created_at", Value: time.Now()},
-		{Key: "updated_at", Value: time.Now()},
-		{Key: "deleted_at", Value: nil},
-	})
+	}
+	if category.ParentId != "" {
+		tree, err := loadCategoryTree(ctx, ropo.db, category.UserId)
+		if err != nil {
+			return &pb.CreateCategoryResp{
+				Status:  "error",
+				Message: err.Error(),
+			}, err
+		}
+		if err := tree.checkParent("", category.ParentId, category.Type, 1); err != nil {
+			return &pb.CreateCategoryResp{
+				Status:  "error",
+				Message: err.Error(),
+			}, err
+		}
+		doc = append(doc, bson.E{Key: "parent_id", Value: category.ParentId})
+	}
+	doc = append(doc,
+		bson.E{Key: "created_at", Value: time.Now()},
+		bson.E{Key: "updated_at", Value: time.Now()},
+		bson.E{Key: "deleted_at", Value: nil},
+	)
+
+	_, err := ropo.coll.InsertOne(ctx, doc)
 	if err != nil {
 		return &pb.CreateCategoryResp{
 			Status:  "error",
@@ -48,6 +89,7 @@ func (ropo *categoryRepositoryImpl) CreateCategory(ctx context.Context, category
 	return &pb.CreateCategoryResp{
 		Status:  "success",
 		Message: "Category created successfully",
+		Id:      id,
 	}, nil
 }
 
@@ -60,24 +102,29 @@ func (repo *categoryRepositoryImpl) UpdateCategory(ctx context.Context, request
 		}},
 	}
 
-	filter := bson.D{
-		{Key: "_id", Value: request.Id},
-		{Key: "deleted_at", Value: nil},
-	}
-
-	res, err := repo.coll.UpdateOne(ctx, filter, updated)
-	if err != nil {
+	var category models.GetCategory
+	if err := findOwned(ctx, repo.coll, request.Id, request.UserId, ErrCategoryNotFound, &category); err != nil {
 		return &pb.UpdateCategoryResp{
 			Status:  "error",
-			Message: "Error updating category: " + err.Error(),
+			Message: err.Error(),
 		}, err
 	}
+	// Daraxtdagi kategoriyalar turi ota kategoriyasi bilan bir xil bo'lishi kerak
+	if !strings.EqualFold(category.Type, request.Type) {
+		if err := repo.checkDetached(ctx, category); err != nil {
+			return &pb.UpdateCategoryResp{
+				Status:  "error",
+				Message: err.Error(),
+			}, err
+		}
+	}
 
-	if res.ModifiedCount == 0 {
+	_, err := repo.coll.UpdateOne(ctx, ownedFilter(request.Id, request.UserId), updated)
+	if err != nil {
 		return &pb.UpdateCategoryResp{
 			Status:  "error",
-			Message: "Category not found",
-		}, nil
+			Message: "Error updating category: " + err.Error(),
+		}, err
 	}
 
 	return &pb.UpdateCategoryResp{
@@ -86,30 +133,58 @@ func (repo *categoryRepositoryImpl) UpdateCategory(ctx context.Context, request
 	}, nil
 }
 
+// DeleteCategory kategoriyani o'chiradi. Kategoriya ishlatilayotgan bo'lsa
+// (tranzaksiyalar, byudjetlar va boshqa yozuvlar), replacement_category_id
+// berilishi shart: bog'langan yozuvlar bitta tranzaksiyada unga o'tkaziladi.
 func (repo *categoryRepositoryImpl) DeleteCategory(ctx context.Context, request *pb.DeleteCategoryReq) (*pb.DeleteCategoryResp, error) {
-	filter := bson.D{
-		{Key: "_id", Value: request.Id},
-		{Key: "user_id", Value: request.UserId},
-		{Key: "deleted_at", Value: nil},
-	}
-
-	res, err := repo.coll.UpdateOne(ctx, filter, bson.D{
-		{Key: "$set", Value: bson.D{
-			{Key: "deleted_at", Value: time.Now()},
-		}},
-	})
-	if err != nil {
+	var category models.GetCategory
+	if err := findOwned(ctx, repo.coll, request.Id, request.UserId, ErrCategoryNotFound, &category); err != nil {
 		return &pb.DeleteCategoryResp{
 			Status:  "error",
 			Message: err.Error(),
 		}, err
 	}
 
-	if res.ModifiedCount == 0 {
+	if request.ReplacementCategoryId != "" {
+		var replacement models.GetCategory
+		err := findOwned(ctx, repo.coll, request.ReplacementCategoryId, request.UserId, ErrCategoryNotFound, &replacement)
+		if err != nil {
+			return &pb.DeleteCategoryResp{
+				Status:  "error",
+				Message: err.Error(),
+			}, err
+		}
+		counts, err := repo.mergeInto(ctx, category, replacement)
+		if err != nil {
+			return &pb.DeleteCategoryResp{
+				Status:  "error",
+				Message: err.Error(),
+			}, err
+		}
+		return &pb.DeleteCategoryResp{
+			Status:            "success",
+			Message:           "Category deleted successfully",
+			TransactionsCount: counts.transactions,
+			BudgetsCount:      counts.budgets,
+		}, nil
+	}
+
+	err := withTransaction(ctx, repo.db, func(sc mongo.SessionContext) error {
+		if err := repo.checkUnreferenced(sc, category); err != nil {
+			return err
+		}
+		_, err := repo.coll.UpdateOne(sc, ownedFilter(category.ID, category.UserId), bson.D{
+			{Key: "$set", Value: bson.D{
+				{Key: "deleted_at", Value: time.Now()},
+			}},
+		})
+		return err
+	})
+	if err != nil {
 		return &pb.DeleteCategoryResp{
 			Status:  "error",
-			Message: "Category not found",
-		}, nil
+			Message: err.Error(),
+		}, err
 	}
 
 	return &pb.DeleteCategoryResp{
@@ -118,86 +193,259 @@ func (repo *categoryRepositoryImpl) DeleteCategory(ctx context.Context, request
 	}, nil
 }
 
-func (repo *categoryRepositoryImpl) GetCategory(ctx context.Context, request *pb.GetCategoryReq) (*pb.GetCategoryResp, error) {
-	filter := bson.D{
-		{Key: "_id", Value: request.Id},
-		{Key: "deleted_at", Value: nil},
+// checkUnreferenced kategoriyaga hech narsa bog'lanmaganini tekshiradi.
+func (repo *categoryRepositoryImpl) checkUnreferenced(ctx context.Context, category models.GetCategory) error {
+	transactions, err := repo.transactions.CountCategoryTransactions(ctx, category.UserId, category.ID)
+	if err != nil {
+		return err
+	}
+	budgets, err := repo.budgets.CountCategoryBudgets(ctx, category.UserId, category.ID)
+	if err != nil {
+		return err
 	}
+	dependents, err := categoryDependents(ctx, repo.db, category.UserId, category.ID)
+	if err != nil {
+		return err
+	}
+	if transactions+budgets+dependents > 0 {
+		return errs.FailedPrecondition(fmt.Sprintf(
+			"category is used by %d transactions, %d budgets and %d other records; provide replacement_category_id",
+			transactions, budgets, dependents))
+	}
+	return nil
+}
 
+func (repo *categoryRepositoryImpl) GetCategory(ctx context.Context, request *pb.GetCategoryReq) (*pb.GetCategoryResp, error) {
 	var category models.GetCategory
-	err := repo.coll.FindOne(ctx, filter).Decode(&category)
-	if err != nil {
+	if err := findOwned(ctx, repo.coll, request.Id, request.UserId, ErrCategoryNotFound, &category); err != nil {
 		return nil, err
 	}
 
 	return &pb.GetCategoryResp{
-		Id:     category.ID,
-		UserId: category.UserId,
-		Name:   category.Name,
-		Type:   category.Type,
+		Id:       category.ID,
+		UserId:   category.UserId,
+		Name:     category.Name,
+		Type:     category.Type,
+		ParentId: category.ParentId,
 	}, nil
 }
 
 func (repo *categoryRepositoryImpl) GetCategoriesList(ctx context.Context, request *pb.GetCategoriesReq) (*pb.GetCategoriesResp, error) {
+	if err := seedDefaultCategories(ctx, repo.db, request.UserId); err != nil {
+		return nil, err
+	}
+
 	// Oddiy filtr yaratish
-	filter := bson.D{}
+	filter := bson.D{{Key: "user_id", Value: request.UserId}}
 
-	if request.UserId != "" {
-		filter = append(filter, bson.E{Key: "user_id", Value: request.UserId})
-	}
 	if request.Name != "" {
-		filter = append(filter, bson.E{Key: "name", Value: bson.D{
-			{Key: "$regex", Value: request.Name},
-			{Key: "$options", Value: "i"},
-		}})
+		filter = append(filter, bson.E{Key: "name", Value: containsRegex(request.Name)})
 	}
 	if request.Type != "" {
 		filter = append(filter, bson.E{Key: "type", Value: request.Type})
 	}
+	if request.ParentId != "" {
+		filter = append(filter, bson.E{Key: "parent_id", Value: request.ParentId})
+	}
 
 	filter = append(filter, bson.E{Key: "deleted_at", Value: nil})
 
+	page := listPage{field: "created_at", page: request.Page, limit: request.Limit, token: request.PageToken}
+
 	// Hujjatlarni sanash
-	totalCount, err := repo.coll.CountDocuments(ctx, filter)
-	if err != nil {
-		return nil, err
+	var totalCount int64
+	if !page.cursorMode() {
+		count, err := repo.coll.CountDocuments(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		totalCount = count
 	}
 
 	// Agregatsiya pipeline yaratish
-	pipeline := mongo.Pipeline{
-		{{Key: "$match", Value: filter}},
-		{{Key: "$skip", Value: (request.Page - 1) * request.Limit}},
-		{{Key: "$limit", Value: request.Limit}},
+	stages, err := page.stages()
+	if err != nil {
+		return nil, err
 	}
+	pipeline := append(mongo.Pipeline{{{Key: "$match", Value: filter}}}, stages...)
 
 	cursor, err := repo.coll.Aggregate(ctx, pipeline)
 	if err != nil {
 		return nil, err
 	}
+	defer cursor.Close(ctx)
 
-	var categories []*pb.Category
-	for cursor.Next(ctx) {
-		var category models.GetCategory
-		err := cursor.Decode(&category)
-		if err != nil {
-			return nil, err
-		}
+	var items []models.GetCategory
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, err
+	}
+	n, more := page.trim(len(items))
 
+	var categories []*pb.Category
+	for _, category := range items[:n] {
 		categories = append(categories, &pb.Category{
-			Id:     category.ID,
-			UserId: category.UserId,
-			Name:   category.Name,
-			Type:   category.Type,
+			Id:       category.ID,
+			UserId:   category.UserId,
+			Name:     category.Name,
+			Type:     category.Type,
+			ParentId: category.ParentId,
 		})
 	}
 
 	if len(categories) == 0 {
-		return nil, errors.New("no categories found")
+		return nil, errs.NotFound("no categories found")
 	}
 
-	return &pb.GetCategoriesResp{
+	resp := &pb.GetCategoriesResp{
 		Categories: categories,
 		TotalCount: totalCount,
 		Limit:      request.Limit,
+	}
+	if more {
+		last := items[n-1]
+		resp.NextPageToken = page.nextToken(last.CreatedAt.UnixMilli(), last.ID)
+	}
+	return resp, nil
+}
+
+// MoveCategory kategoriyani ichki kategoriyalari bilan birga boshqa ota kategoriya
+// ostiga ko'chiradi; parent_id bo'sh bo'lsa kategoriya yuqori darajaga chiqadi.
+func (repo *categoryRepositoryImpl) MoveCategory(ctx context.Context, request *pb.MoveCategoryReq) (*pb.MoveCategoryResp, error) {
+	var category models.GetCategory
+	if err := findOwned(ctx, repo.coll, request.Id, request.UserId, ErrCategoryNotFound, &category); err != nil {
+		return &pb.MoveCategoryResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	update := bson.D{{Key: "$unset", Value: bson.D{{Key: "parent_id", Value: ""}}}}
+	if request.ParentId != "" {
+		tree, err := loadCategoryTree(ctx, repo.db, request.UserId)
+		if err != nil {
+			return &pb.MoveCategoryResp{
+				Status:  "error",
+				Message: err.Error(),
+			}, err
+		}
+		if err := tree.checkParent(category.ID, request.ParentId, category.Type, tree.height(category.ID)); err != nil {
+			return &pb.MoveCategoryResp{
+				Status:  "error",
+				Message: err.Error(),
+			}, err
+		}
+		update = bson.D{{Key: "$set", Value: bson.D{{Key: "parent_id", Value: request.ParentId}}}}
+	}
+	update = append(update, bson.E{Key: "$currentDate", Value: bson.D{{Key: "updated_at", Value: true}}})
+
+	if _, err := repo.coll.UpdateOne(ctx, ownedFilter(request.Id, request.UserId), update); err != nil {
+		return &pb.MoveCategoryResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.MoveCategoryResp{
+		Status:  "success",
+		Message: "Category moved successfully",
 	}, nil
 }
+
+// MergeCategories source kategoriyani target ga qo'shadi: unga bog'langan
+// tranzaksiyalar, byudjetlar va boshqa yozuvlar hamda ichki kategoriyalari
+// target ga o'tkaziladi, source esa o'chiriladi.
+func (repo *categoryRepositoryImpl) MergeCategories(ctx context.Context, request *pb.MergeCategoriesReq) (*pb.MergeCategoriesResp, error) {
+	var source, target models.GetCategory
+	if err := findOwned(ctx, repo.coll, request.SourceId, request.UserId, ErrCategoryNotFound, &source); err != nil {
+		return &pb.MergeCategoriesResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	if err := findOwned(ctx, repo.coll, request.TargetId, request.UserId, ErrCategoryNotFound, &target); err != nil {
+		return &pb.MergeCategoriesResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	counts, err := repo.mergeInto(ctx, source, target)
+	if err != nil {
+		return &pb.MergeCategoriesResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.MergeCategoriesResp{
+		Status:            "success",
+		Message:           "Categories merged successfully",
+		TransactionsCount: counts.transactions,
+		BudgetsCount:      counts.budgets,
+	}, nil
+}
+
+// mergeInto source ga bog'langan yozuvlarni target ga o'tkazib, source ni
+// o'chiradi. Hammasi bitta tranzaksiyada.
+func (repo *categoryRepositoryImpl) mergeInto(ctx context.Context, source, target models.GetCategory) (categoryReferences, error) {
+	var counts categoryReferences
+	err := withTransaction(ctx, repo.db, func(sc mongo.SessionContext) error {
+		tree, err := loadCategoryTree(sc, repo.db, source.UserId)
+		if err != nil {
+			return err
+		}
+		if err := checkMerge(tree, source, target); err != nil {
+			return err
+		}
+
+		counts, err = reassignCategory(sc, repo.db, source.UserId, source.ID, target.ID)
+		if err != nil {
+			return err
+		}
+		_, err = repo.coll.UpdateOne(sc, ownedFilter(source.ID, source.UserId), bson.D{
+			{Key: "$set", Value: bson.D{{Key: "deleted_at", Value: time.Now()}}},
+		})
+		return err
+	})
+	return counts, err
+}
+
+// checkMerge source ni target ga qo'shish mumkinligini tekshiradi. source ning
+// bolalari target ostiga o'tgani uchun daraxt chuqurligi ham tekshiriladi.
+func checkMerge(tree *categoryTree, source, target models.GetCategory) error {
+	if source.ID == target.ID {
+		return errs.InvalidField("target_id", "must be different from source_id")
+	}
+	if !strings.EqualFold(source.Type, target.Type) {
+		return errs.FailedPrecondition("categories have different types")
+	}
+	for _, descendant := range tree.subtree(source.ID) {
+		if descendant == target.ID {
+			return errs.FailedPrecondition("category cannot be merged into its own subcategory")
+		}
+	}
+	if tree.depth(target.ID)+tree.height(source.ID)-1 > MaxCategoryDepth {
+		return errs.FailedPrecondition("category tree is too deep")
+	}
+	return nil
+}
+
+// checkDetached kategoriya daraxtda (ota yoki ichki kategoriyalari bilan) bo'lsa,
+// FailedPrecondition qaytaradi.
+func (repo *categoryRepositoryImpl) checkDetached(ctx context.Context, category models.GetCategory) error {
+	if category.ParentId != "" {
+		return errs.FailedPrecondition("category type must match its parent category")
+	}
+	count, err := repo.coll.CountDocuments(ctx, bson.D{
+		{Key: "user_id", Value: category.UserId},
+		{Key: "parent_id", Value: category.ID},
+		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errs.FailedPrecondition("category type must match its subcategories")
+	}
+	return nil
+}
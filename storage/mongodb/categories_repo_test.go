@@ -37,9 +37,10 @@ func TestUpdateCategory(t *testing.T) {
 
 	repo := NewCategoryRepository(db)
 	category := &pb.UpdateCategoryReq{
-		Id:   "d9d36573-5ee9-48e3-80a0-257172f64c72",
-		Name: "Updated Test Category",
-		Type: "INCOME",
+		Id:     "d9d36573-5ee9-48e3-80a0-257172f64c72",
+		Name:   "Updated Test Category",
+		Type:   "INCOME",
+		UserId: "test_user_id",
 	}
 
 	resp, err := repo.UpdateCategory(context.Background(), category)
@@ -74,7 +75,8 @@ func TestGetCategory(t *testing.T) {
 
 	repo := NewCategoryRepository(db)
 	resp, err := repo.GetCategory(context.Background(), &pb.GetCategoryReq{
-		Id: "76db8dc2-8e59-48d0-8f73-e09059008435",
+		Id:     "76db8dc2-8e59-48d0-8f73-e09059008435",
+		UserId: "test_user_id",
 	})
 	assert.NoError(t, err)
 
@@ -92,6 +94,7 @@ func TestGetCategoriesList(t *testing.T) {
     resp, err := repo.GetCategoriesList(context.Background(), &pb.GetCategoriesReq{
         Name: "Test Category",
 		Type: "EXPENSE",
+        UserId: "test_user_id",
     })
     assert.NoError(t, err)
 
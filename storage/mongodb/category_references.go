@@ -0,0 +1,94 @@
+package mongodb
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// categoryReferences kategoriyaga bog'langan yozuvlar soni.
+type categoryReferences struct {
+	transactions int64
+	budgets      int64
+}
+
+// categoryDependents kategoriyaga bog'langan o'chirilmagan takroriy tranzaksiyalar,
+// avtomatik qoidalar va ichki kategoriyalar soni.
+func categoryDependents(ctx context.Context, db *mongo.Database, userId, categoryId string) (int64, error) {
+	var total int64
+	for collection, field := range map[string]string{
+		"recurring_transactions": "category_id",
+		"category_rules":         "category_id",
+		"categories":             "parent_id",
+	} {
+		count, err := db.Collection(collection).CountDocuments(ctx, bson.D{
+			{Key: "user_id", Value: userId},
+			{Key: field, Value: categoryId},
+			{Key: "deleted_at", Value: nil},
+		})
+		if err != nil {
+			return 0, err
+		}
+		total += count
+	}
+	return total, nil
+}
+
+// reassignCategory foydalanuvchining fromId kategoriyasiga bog'langan barcha
+// yozuvlarini toId ga o'tkazadi: tranzaksiyalar va ularning bo'linishlari,
+// jurnal yozuvlari, byudjetlar va ularning tarixi, takroriy tranzaksiyalar,
+// avtomatik qoidalar hamda ichki kategoriyalar. Kategoriya taklif modeli
+// qaytadan o'qitilishi uchun o'chiriladi. Tranzaksiya ichida chaqiriladi.
+func reassignCategory(ctx context.Context, db *mongo.Database, userId, fromId, toId string) (categoryReferences, error) {
+	var counts categoryReferences
+	set := bson.D{{Key: "$set", Value: bson.D{{Key: "category_id", Value: toId}}}}
+	owned := func(field string) bson.D {
+		return bson.D{{Key: "user_id", Value: userId}, {Key: field, Value: fromId}}
+	}
+
+	result, err := db.Collection("transactions").UpdateMany(ctx, owned("category_id"), set)
+	if err != nil {
+		return counts, err
+	}
+	counts.transactions = result.ModifiedCount
+	result, err = db.Collection("transactions").UpdateMany(ctx, owned("splits.category_id"),
+		bson.D{{Key: "$set", Value: bson.D{{Key: "splits.$[split].category_id", Value: toId}}}},
+		options.Update().SetArrayFilters(options.ArrayFilters{
+			Filters: []interface{}{bson.D{{Key: "split.category_id", Value: fromId}}},
+		}),
+	)
+	if err != nil {
+		return counts, err
+	}
+	counts.transactions += result.ModifiedCount
+
+	_, err = db.Collection("journal").UpdateMany(ctx, bson.D{
+		{Key: "user_id", Value: userId},
+		{Key: "ledger", Value: LedgerCategory},
+		{Key: "ledger_id", Value: fromId},
+	}, bson.D{{Key: "$set", Value: bson.D{{Key: "ledger_id", Value: toId}}}})
+	if err != nil {
+		return counts, err
+	}
+
+	result, err = db.Collection("budgets").UpdateMany(ctx, owned("category_id"), set)
+	if err != nil {
+		return counts, err
+	}
+	counts.budgets = result.ModifiedCount
+	for _, collection := range []string{"budget_history", "recurring_transactions", "category_rules"} {
+		if _, err := db.Collection(collection).UpdateMany(ctx, owned("category_id"), set); err != nil {
+			return counts, err
+		}
+	}
+
+	_, err = db.Collection("categories").UpdateMany(ctx, owned("parent_id"),
+		bson.D{{Key: "$set", Value: bson.D{{Key: "parent_id", Value: toId}}}})
+	if err != nil {
+		return counts, err
+	}
+
+	return counts, resetCategoryModel(ctx, db, userId)
+}
@@ -0,0 +1,507 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
+	"budgeting-service/pkg/rules"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type CategoryRuleRepository interface {
+	CreateCategoryRule(ctx context.Context, request *pb.CreateCategoryRuleReq) (*pb.CreateCategoryRuleResp, error)
+	GetCategoryRule(ctx context.Context, request *pb.GetCategoryRuleReq) (*pb.GetCategoryRuleResp, error)
+	GetCategoryRulesList(ctx context.Context, request *pb.GetCategoryRulesListReq) (*pb.GetCategoryRulesListResp, error)
+	UpdateCategoryRule(ctx context.Context, request *pb.UpdateCategoryRuleReq) (*pb.UpdateCategoryRuleResp, error)
+	DeleteCategoryRule(ctx context.Context, request *pb.DeleteCategoryRuleReq) (*pb.DeleteCategoryRuleResp, error)
+	TestCategoryRules(ctx context.Context, request *pb.TestCategoryRulesReq) (*pb.TestCategoryRulesResp, error)
+	ApplyCategoryRules(ctx context.Context, request *pb.ApplyCategoryRulesReq) (*pb.ApplyCategoryRulesResp, error)
+}
+
+type categoryRuleRepositoryImpl struct {
+	db           *mongo.Database
+	coll         *mongo.Collection
+	transactions *mongo.Collection
+	journal      JournalRepository
+}
+
+func NewCategoryRuleRepository(db *mongo.Database) CategoryRuleRepository {
+	return &categoryRuleRepositoryImpl{
+		db:           db,
+		coll:         db.Collection("category_rules"),
+		transactions: db.Collection("transactions"),
+		journal:      NewJournalRepository(db),
+	}
+}
+
+func (repo *categoryRuleRepositoryImpl) CreateCategoryRule(ctx context.Context, request *pb.CreateCategoryRuleReq) (*pb.CreateCategoryRuleResp, error) {
+	rule := models.CategoryRule{
+		ID:                   uuid.NewString(),
+		UserId:               request.UserId,
+		Name:                 request.Name,
+		Priority:             request.Priority,
+		Disabled:             request.Disabled,
+		DescriptionContains:  request.DescriptionContains,
+		DescriptionRegex:     request.DescriptionRegex,
+		CounterpartyContains: request.CounterpartyContains,
+		AccountId:            request.AccountId,
+		MinAmount:            request.MinAmount,
+		MaxAmount:            request.MaxAmount,
+		Type:                 request.Type,
+		CategoryId:           request.CategoryId,
+		Tags:                 normalizeTags(request.Tags),
+		CreatedAt:            time.Now(),
+		UpdatedAt:            time.Now(),
+	}
+	if err := repo.checkRule(ctx, rule); err != nil {
+		return &pb.CreateCategoryRuleResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	if _, err := repo.coll.InsertOne(ctx, rule); err != nil {
+		return &pb.CreateCategoryRuleResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.CreateCategoryRuleResp{
+		Status:  "success",
+		Message: "category rule created successfully",
+		Id:      rule.ID,
+	}, nil
+}
+
+func (repo *categoryRuleRepositoryImpl) GetCategoryRule(ctx context.Context, request *pb.GetCategoryRuleReq) (*pb.GetCategoryRuleResp, error) {
+	var rule models.CategoryRule
+	if err := findOwned(ctx, repo.coll, request.Id, request.UserId, ErrCategoryRuleNotFound, &rule); err != nil {
+		return nil, err
+	}
+	return &pb.GetCategoryRuleResp{Rule: categoryRuleToPb(rule)}, nil
+}
+
+// GetCategoryRulesList qoidalarni ular tekshiriladigan tartibda qaytaradi.
+func (repo *categoryRuleRepositoryImpl) GetCategoryRulesList(ctx context.Context, request *pb.GetCategoryRulesListReq) (*pb.GetCategoryRulesListResp, error) {
+	list, err := findCategoryRules(ctx, repo.db, bson.D{
+		{Key: "user_id", Value: request.UserId},
+		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errs.NotFound("category rules not found")
+	}
+
+	var results []*pb.CategoryRule
+	for _, rule := range list {
+		results = append(results, categoryRuleToPb(rule))
+	}
+	return &pb.GetCategoryRulesListResp{Rules: results}, nil
+}
+
+// UpdateCategoryRule qoidani to'liq almashtiradi: berilmagan shartlar o'chiriladi.
+func (repo *categoryRuleRepositoryImpl) UpdateCategoryRule(ctx context.Context, request *pb.UpdateCategoryRuleReq) (*pb.UpdateCategoryRuleResp, error) {
+	var rule models.CategoryRule
+	if err := findOwned(ctx, repo.coll, request.Id, request.UserId, ErrCategoryRuleNotFound, &rule); err != nil {
+		return &pb.UpdateCategoryRuleResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	rule.Name = request.Name
+	rule.Priority = request.Priority
+	rule.Disabled = request.Disabled
+	rule.DescriptionContains = request.DescriptionContains
+	rule.DescriptionRegex = request.DescriptionRegex
+	rule.CounterpartyContains = request.CounterpartyContains
+	rule.AccountId = request.AccountId
+	rule.MinAmount = request.MinAmount
+	rule.MaxAmount = request.MaxAmount
+	rule.Type = request.Type
+	rule.CategoryId = request.CategoryId
+	rule.Tags = normalizeTags(request.Tags)
+	rule.UpdatedAt = time.Now()
+	if err := repo.checkRule(ctx, rule); err != nil {
+		return &pb.UpdateCategoryRuleResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	if _, err := repo.coll.ReplaceOne(ctx, ownedFilter(rule.ID, rule.UserId), rule); err != nil {
+		return &pb.UpdateCategoryRuleResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.UpdateCategoryRuleResp{
+		Status:  "success",
+		Message: "category rule updated successfully",
+	}, nil
+}
+
+func (repo *categoryRuleRepositoryImpl) DeleteCategoryRule(ctx context.Context, request *pb.DeleteCategoryRuleReq) (*pb.DeleteCategoryRuleResp, error) {
+	if err := findOwned(ctx, repo.coll, request.Id, request.UserId, ErrCategoryRuleNotFound, nil); err != nil {
+		return &pb.DeleteCategoryRuleResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	_, err := repo.coll.UpdateOne(ctx, ownedFilter(request.Id, request.UserId), bson.D{
+		{Key: "$set", Value: bson.D{{Key: "deleted_at", Value: time.Now()}}},
+	})
+	if err != nil {
+		return &pb.DeleteCategoryRuleResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.DeleteCategoryRuleResp{
+		Status:  "success",
+		Message: "category rule deleted successfully",
+	}, nil
+}
+
+// TestCategoryRules berilgan tranzaksiyaga qaysi qoida mos kelishini ko'rsatadi.
+// Hech narsa saqlanmaydi.
+func (repo *categoryRuleRepositoryImpl) TestCategoryRules(ctx context.Context, request *pb.TestCategoryRulesReq) (*pb.TestCategoryRulesResp, error) {
+	list, err := activeCategoryRules(ctx, repo.db, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	match, ok := newCategoryRuleMatcher(list).match(models.GetTransaction{
+		AccountId:    request.AccountId,
+		Type:         request.Type,
+		Amount:       request.Amount,
+		Description:  request.Description,
+		Counterparty: request.Counterparty,
+	})
+	if !ok {
+		return &pb.TestCategoryRulesResp{Matched: false}, nil
+	}
+	return &pb.TestCategoryRulesResp{
+		Matched: true,
+		Rule:    categoryRuleToPb(match),
+	}, nil
+}
+
+// ApplyCategoryRules qoidalarni mavjud tranzaksiyalarga qayta qo'llaydi.
+// Faqat foydalanuvchi editor bo'lgan hisoblardagi uning tranzaksiyalari
+// o'zgartiriladi; o'tkazmalar va bo'lingan tranzaksiyalar o'tkazib yuboriladi.
+func (repo *categoryRuleRepositoryImpl) ApplyCategoryRules(ctx context.Context, request *pb.ApplyCategoryRulesReq) (*pb.ApplyCategoryRulesResp, error) {
+	filter := bson.D{
+		{Key: "user_id", Value: request.UserId},
+		{Key: "deleted_at", Value: nil},
+		{Key: "transfer_id", Value: bson.D{{Key: "$exists", Value: false}}},
+		{Key: "splits", Value: bson.D{{Key: "$exists", Value: false}}},
+	}
+	if request.AccountId != "" {
+		if _, err := checkAccountRole(ctx, repo.db, request.AccountId, request.UserId, AccountRoleEditor); err != nil {
+			return nil, err
+		}
+		filter = append(filter, bson.E{Key: "account_id", Value: request.AccountId})
+	}
+	// Eski tranzaksiyalarda category_id maydoni umuman bo'lmasligi mumkin
+	if !request.Overwrite {
+		filter = append(filter, bson.E{Key: "category_id", Value: bson.D{{Key: "$in", Value: bson.A{nil, ""}}}})
+	}
+	date := bson.D{}
+	if request.DateFrom != "" {
+		from, err := parseDate("date_from", "2006-01-02 15:04:05", request.DateFrom)
+		if err != nil {
+			return nil, err
+		}
+		date = append(date, bson.E{Key: "$gte", Value: from})
+	}
+	if request.DateTo != "" {
+		to, err := parseDate("date_to", "2006-01-02 15:04:05", request.DateTo)
+		if err != nil {
+			return nil, err
+		}
+		date = append(date, bson.E{Key: "$lte", Value: to})
+	}
+	if len(date) > 0 {
+		filter = append(filter, bson.E{Key: "date", Value: date})
+	}
+
+	list, err := activeCategoryRules(ctx, repo.db, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	matcher := newCategoryRuleMatcher(list)
+
+	cursor, err := repo.transactions.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	resp := &pb.ApplyCategoryRulesResp{Status: "success"}
+	// Hisob bo'yicha editor huquqi va valyutasi, kategoriya mavjudligi bir marta tekshiriladi
+	accounts := map[string]*models.GetAccount{}
+	categories := map[string]bool{}
+	for cursor.Next(ctx) {
+		var transaction models.GetTransaction
+		if err := cursor.Decode(&transaction); err != nil {
+			return nil, err
+		}
+		account, checked := accounts[transaction.AccountId]
+		if !checked {
+			account, err = checkAccountRole(ctx, repo.db, transaction.AccountId, request.UserId, AccountRoleEditor)
+			if err != nil && !errors.Is(err, ErrPermissionDenied) && !errors.Is(err, ErrAccountNotFound) {
+				return nil, err
+			}
+			accounts[transaction.AccountId] = account
+		}
+		if account == nil {
+			continue
+		}
+		resp.ScannedCount++
+
+		match, ok := matcher.match(transaction)
+		if !ok {
+			continue
+		}
+		resp.MatchedCount++
+
+		categoryId := transaction.CategoryId
+		if match.CategoryId != "" {
+			exists, checked := categories[match.CategoryId]
+			if !checked {
+				exists, err = categoryExists(ctx, repo.db, match.CategoryId, request.UserId)
+				if err != nil {
+					return nil, err
+				}
+				categories[match.CategoryId] = exists
+			}
+			if exists {
+				categoryId = match.CategoryId
+			}
+		}
+		tags := normalizeTags(append(append([]string{}, transaction.Tags...), match.Tags...))
+		if categoryId == transaction.CategoryId && len(tags) == len(transaction.Tags) && match.ID == transaction.CategoryRuleId {
+			continue
+		}
+		resp.UpdatedCount++
+		if request.DryRun {
+			continue
+		}
+
+		set := bson.D{
+			{Key: "category_id", Value: categoryId},
+			{Key: "category_rule_id", Value: match.ID},
+			{Key: "updated_at", Value: time.Now()},
+		}
+		unset := bson.D{}
+		// null tags keyingi $addToSet ni buzadi, shuning uchun bo'sh ro'yxat saqlanmaydi
+		if len(tags) > 0 {
+			set = append(set, bson.E{Key: "tags", Value: tags})
+		} else {
+			unset = append(unset, bson.E{Key: "tags", Value: ""})
+		}
+		update := bson.D{{Key: "$set", Value: set}}
+		if len(unset) > 0 {
+			update = append(update, bson.E{Key: "$unset", Value: unset})
+		}
+
+		err = withTransaction(ctx, repo.db, func(sc mongo.SessionContext) error {
+			_, err := repo.transactions.UpdateOne(sc, bson.D{{Key: "_id", Value: transaction.Id}}, update)
+			if err != nil || categoryId == transaction.CategoryId {
+				return err
+			}
+			updated := transaction
+			updated.CategoryId = categoryId
+			if err := relearnCategory(sc, repo.db, transaction, updated); err != nil {
+				return err
+			}
+			// Journal yozuvlari kategoriya bo'yicha, shuning uchun qayta yoziladi
+			if err := repo.journal.VoidTransaction(sc, transaction.Id); err != nil {
+				return err
+			}
+			return repo.journal.PostTransaction(sc, updated, account.Currency)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	resp.Message = "category rules applied successfully"
+	if request.DryRun {
+		resp.Message = "dry run: no transactions were changed"
+	}
+	return resp, nil
+}
+
+// checkRule qoida shartlarini, regexni va unga bog'langan hisob hamda
+// kategoriyani tekshiradi.
+func (repo *categoryRuleRepositoryImpl) checkRule(ctx context.Context, rule models.CategoryRule) error {
+	candidate := toRule(rule)
+	if !candidate.HasConditions() {
+		return errs.InvalidField("conditions", "at least one condition is required")
+	}
+	if _, err := candidate.Compile(); err != nil {
+		return errs.InvalidField("description_regex", err.Error())
+	}
+	if rule.CategoryId == "" && len(rule.Tags) == 0 {
+		return errs.InvalidField("category_id", "category_id or tags is required")
+	}
+	if rule.AccountId != "" {
+		if _, err := checkAccountRole(ctx, repo.db, rule.AccountId, rule.UserId, AccountRoleEditor); err != nil {
+			return err
+		}
+	}
+	if rule.CategoryId != "" {
+		return findOwned(ctx, repo.db.Collection("categories"), rule.CategoryId, rule.UserId, ErrCategoryNotFound, nil)
+	}
+	return nil
+}
+
+// categorizeTransaction kategoriyasiz tranzaksiyaga birinchi mos kelgan qoidaning
+// kategoriyasi va teglarini beradi. Qoidadagi kategoriya o'chirilgan bo'lsa,
+// faqat teglar qo'shiladi.
+func categorizeTransaction(ctx context.Context, db *mongo.Database, transaction *models.GetTransaction) error {
+	if transaction.CategoryId != "" || len(transaction.Splits) > 0 || transaction.TransferId != "" {
+		return nil
+	}
+	list, err := activeCategoryRules(ctx, db, transaction.UserId)
+	if err != nil {
+		return err
+	}
+	match, ok := newCategoryRuleMatcher(list).match(*transaction)
+	if !ok {
+		return nil
+	}
+
+	if match.CategoryId != "" {
+		exists, err := categoryExists(ctx, db, match.CategoryId, transaction.UserId)
+		if err != nil {
+			return err
+		}
+		if exists {
+			transaction.CategoryId = match.CategoryId
+		}
+	}
+	transaction.Tags = normalizeTags(append(transaction.Tags, match.Tags...))
+	transaction.CategoryRuleId = match.ID
+	return nil
+}
+
+// categoryRuleMatcher foydalanuvchi qoidalari: regexlar bir marta kompilyatsiya
+// qilinadi va ko'p tranzaksiyaga qo'llanadi.
+type categoryRuleMatcher struct {
+	matcher *rules.Matcher
+	byId    map[string]models.CategoryRule
+}
+
+func newCategoryRuleMatcher(list []models.CategoryRule) *categoryRuleMatcher {
+	candidates := make([]rules.Rule, len(list))
+	byId := make(map[string]models.CategoryRule, len(list))
+	for i, rule := range list {
+		candidates[i] = toRule(rule)
+		byId[rule.ID] = rule
+	}
+	return &categoryRuleMatcher{matcher: rules.NewMatcher(candidates), byId: byId}
+}
+
+// match tranzaksiyaga birinchi mos kelgan qoidani topadi.
+func (m *categoryRuleMatcher) match(transaction models.GetTransaction) (models.CategoryRule, bool) {
+	match, ok := m.matcher.First(rules.Transaction{
+		AccountId:    transaction.AccountId,
+		Description:  transaction.Description,
+		Counterparty: transaction.Counterparty,
+		Amount:       transaction.Amount,
+		Type:         transaction.Type,
+	})
+	if !ok {
+		return models.CategoryRule{}, false
+	}
+	return m.byId[match.Id], true
+}
+
+// activeCategoryRules foydalanuvchining yoqilgan qoidalari.
+func activeCategoryRules(ctx context.Context, db *mongo.Database, userId string) ([]models.CategoryRule, error) {
+	return findCategoryRules(ctx, db, bson.D{
+		{Key: "user_id", Value: userId},
+		{Key: "disabled", Value: false},
+		{Key: "deleted_at", Value: nil},
+	})
+}
+
+// findCategoryRules qoidalarni priority, so'ng yaratilgan vaqti bo'yicha qaytaradi.
+func findCategoryRules(ctx context.Context, db *mongo.Database, filter bson.D) ([]models.CategoryRule, error) {
+	cursor, err := db.Collection("category_rules").Find(ctx, filter, options.Find().SetSort(bson.D{
+		{Key: "priority", Value: 1},
+		{Key: "created_at", Value: 1},
+	}))
+	if err != nil {
+		return nil, err
+	}
+	var list []models.CategoryRule
+	if err := cursor.All(ctx, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func categoryExists(ctx context.Context, db *mongo.Database, categoryId, userId string) (bool, error) {
+	err := findOwned(ctx, db.Collection("categories"), categoryId, userId, ErrCategoryNotFound, nil)
+	if errors.Is(err, ErrCategoryNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func toRule(rule models.CategoryRule) rules.Rule {
+	return rules.Rule{
+		Id:                   rule.ID,
+		Priority:             rule.Priority,
+		Disabled:             rule.Disabled,
+		DescriptionContains:  rule.DescriptionContains,
+		DescriptionRegex:     rule.DescriptionRegex,
+		CounterpartyContains: rule.CounterpartyContains,
+		AccountId:            rule.AccountId,
+		MinAmount:            rule.MinAmount,
+		MaxAmount:            rule.MaxAmount,
+		Type:                 rule.Type,
+		CategoryId:           rule.CategoryId,
+		Tags:                 rule.Tags,
+	}
+}
+
+func categoryRuleToPb(rule models.CategoryRule) *pb.CategoryRule {
+	return &pb.CategoryRule{
+		Id:                   rule.ID,
+		UserId:               rule.UserId,
+		Name:                 rule.Name,
+		Priority:             rule.Priority,
+		Disabled:             rule.Disabled,
+		DescriptionContains:  rule.DescriptionContains,
+		DescriptionRegex:     rule.DescriptionRegex,
+		CounterpartyContains: rule.CounterpartyContains,
+		AccountId:            rule.AccountId,
+		MinAmount:            rule.MinAmount,
+		MaxAmount:            rule.MaxAmount,
+		Type:                 rule.Type,
+		CategoryId:           rule.CategoryId,
+		Tags:                 rule.Tags,
+		CreatedAt:            rule.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:            rule.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
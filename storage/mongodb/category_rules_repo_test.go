@@ -0,0 +1,27 @@
+package mongodb
+
+import (
+	"budgeting-service/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchCategoryRule(t *testing.T) {
+	matcher := newCategoryRuleMatcher([]models.CategoryRule{
+		{ID: "groceries", Priority: 5, DescriptionContains: "market", CategoryId: "food"},
+		{ID: "big", Priority: 1, MinAmount: 100000, Type: "expense", Tags: []string{"review"}},
+	})
+
+	match, ok := matcher.match(models.GetTransaction{Description: "Corner Market", Type: "expense", Amount: 2500})
+	assert.True(t, ok)
+	assert.Equal(t, "groceries", match.ID)
+	assert.Equal(t, "food", match.CategoryId)
+
+	match, ok = matcher.match(models.GetTransaction{Description: "Corner Market", Type: "expense", Amount: 150000})
+	assert.True(t, ok)
+	assert.Equal(t, "big", match.ID)
+
+	_, ok = matcher.match(models.GetTransaction{Description: "Salary", Type: "income", Amount: 150000})
+	assert.False(t, ok)
+}
@@ -0,0 +1,178 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/models"
+	"budgeting-service/pkg/bayes"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// DefaultSuggestionLimit limit berilmaganda qaytariladigan takliflar soni
+const DefaultSuggestionLimit = 3
+
+// SuggestCategory tavsif va summa bo'yicha foydalanuvchining ehtimoliy
+// kategoriyalarini qaytaradi. Model foydalanuvchining o'z kategoriyalangan
+// tranzaksiyalaridan o'qitiladi; tarix bo'lmasa ro'yxat bo'sh bo'ladi.
+func (repo *categoryRepositoryImpl) SuggestCategory(ctx context.Context, request *pb.SuggestCategoryReq) (*pb.SuggestCategoryResp, error) {
+	filter := bson.D{
+		{Key: "user_id", Value: request.UserId},
+		{Key: "deleted_at", Value: nil},
+	}
+	cursor, err := repo.coll.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var categories []models.GetCategory
+	if err := cursor.All(ctx, &categories); err != nil {
+		return nil, err
+	}
+	// O'chirilgan va boshqa turdagi kategoriyalar taklif qilinmaydi
+	names := map[string]string{}
+	allowed := map[string]bool{}
+	for _, category := range categories {
+		names[category.ID] = category.Name
+		if request.Type == "" || strings.EqualFold(category.Type, request.Type) {
+			allowed[category.ID] = true
+		}
+	}
+
+	model, err := loadCategoryModel(ctx, repo.db, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(request.Limit)
+	if limit == 0 {
+		limit = DefaultSuggestionLimit
+	}
+	resp := &pb.SuggestCategoryResp{}
+	features := bayes.Features(request.Description+" "+request.Counterparty, request.Amount)
+	for _, suggestion := range model.Rank(features, allowed, limit) {
+		resp.Suggestions = append(resp.Suggestions, &pb.CategorySuggestion{
+			CategoryId: suggestion.Class,
+			Name:       names[suggestion.Class],
+			Score:      suggestion.Score,
+		})
+	}
+	return resp, nil
+}
+
+// loadCategoryModel foydalanuvchi modelini o'qiydi. Model hali bo'lmasa, u
+// butun tarixdan o'qitiladi va saqlanadi; keyin learnCategory bilan yangilanadi.
+func loadCategoryModel(ctx context.Context, db *mongo.Database, userId string) (*bayes.Model, error) {
+	var doc models.CategoryModel
+	err := db.Collection("category_models").FindOne(ctx, bson.D{{Key: "_id", Value: userId}}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return trainCategoryModel(ctx, db, userId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	model := bayes.New()
+	model.Docs = doc.Docs
+	for categoryId, category := range doc.Categories {
+		model.Classes[categoryId] = &bayes.Class{
+			Docs:     category.Docs,
+			Tokens:   category.Tokens,
+			Features: category.Features,
+		}
+	}
+	return model, nil
+}
+
+func trainCategoryModel(ctx context.Context, db *mongo.Database, userId string) (*bayes.Model, error) {
+	cursor, err := db.Collection("transactions").Find(ctx, bson.D{
+		{Key: "user_id", Value: userId},
+		{Key: "deleted_at", Value: nil},
+		{Key: "category_id", Value: bson.D{{Key: "$nin", Value: bson.A{nil, ""}}}},
+		{Key: "transfer_id", Value: bson.D{{Key: "$exists", Value: false}}},
+		{Key: "splits", Value: bson.D{{Key: "$exists", Value: false}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	model := bayes.New()
+	for cursor.Next(ctx) {
+		var transaction models.GetTransaction
+		if err := cursor.Decode(&transaction); err != nil {
+			return nil, err
+		}
+		model.Add(transaction.CategoryId, transactionFeatures(transaction), 1)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	doc := models.CategoryModel{
+		UserId:     userId,
+		Docs:       model.Docs,
+		Categories: map[string]models.CategoryFeatures{},
+		UpdatedAt:  time.Now(),
+	}
+	for categoryId, category := range model.Classes {
+		doc.Categories[categoryId] = models.CategoryFeatures{
+			Docs:     category.Docs,
+			Tokens:   category.Tokens,
+			Features: category.Features,
+		}
+	}
+	_, err = db.Collection("category_models").InsertOne(ctx, doc)
+	// Parallel so'rov modelni allaqachon saqlagan
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return nil, err
+	}
+	return model, nil
+}
+
+// learnCategory tranzaksiyani foydalanuvchi modeliga qo'shadi (weight 1) yoki
+// undan chiqaradi (weight -1). Model hali o'qitilmagan bo'lsa hech narsa
+// qilinmaydi: birinchi taklifda u butun tarixdan o'qitiladi.
+func learnCategory(ctx context.Context, db *mongo.Database, transaction models.GetTransaction, weight int64) error {
+	if transaction.CategoryId == "" || transaction.TransferId != "" || len(transaction.Splits) > 0 {
+		return nil
+	}
+	features := transactionFeatures(transaction)
+	prefix := "categories." + transaction.CategoryId + "."
+	inc := bson.D{
+		{Key: "docs", Value: weight},
+		{Key: prefix + "docs", Value: weight},
+		{Key: prefix + "tokens", Value: weight * int64(len(features))},
+	}
+	for _, feature := range features {
+		inc = append(inc, bson.E{Key: prefix + "features." + feature, Value: weight})
+	}
+	_, err := db.Collection("category_models").UpdateOne(ctx, bson.D{{Key: "_id", Value: transaction.UserId}}, bson.D{
+		{Key: "$inc", Value: inc},
+		{Key: "$set", Value: bson.D{{Key: "updated_at", Value: time.Now()}}},
+	})
+	return err
+}
+
+// relearnCategory o'zgargan tranzaksiyaning eski holatini modeldan chiqarib,
+// yangisini qo'shadi.
+func relearnCategory(ctx context.Context, db *mongo.Database, old, updated models.GetTransaction) error {
+	if err := learnCategory(ctx, db, old, -1); err != nil {
+		return err
+	}
+	return learnCategory(ctx, db, updated, 1)
+}
+
+// resetCategoryModel foydalanuvchi modelini o'chiradi: kategoriyalar ommaviy
+// o'zgarganda keyingi taklif uni butun tarixdan qayta o'qitadi.
+func resetCategoryModel(ctx context.Context, db *mongo.Database, userId string) error {
+	_, err := db.Collection("category_models").DeleteOne(ctx, bson.D{{Key: "_id", Value: userId}})
+	return err
+}
+
+func transactionFeatures(transaction models.GetTransaction) []string {
+	return bayes.Features(transaction.Description+" "+transaction.Counterparty, transaction.Amount)
+}
@@ -0,0 +1,88 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CategoryTemplate yangi foydalanuvchiga yaratiladigan kategoriya va uning bolalari.
+type CategoryTemplate struct {
+	Name     string
+	Type     string
+	Children []string
+}
+
+// DefaultCategories foydalanuvchida hali birorta kategoriya bo'lmaganda yaratiladi.
+var DefaultCategories = []CategoryTemplate{
+	{Name: "Food", Type: TransactionTypeExpense, Children: []string{"Groceries", "Restaurants"}},
+	{Name: "Housing", Type: TransactionTypeExpense, Children: []string{"Rent", "Utilities"}},
+	{Name: "Transport", Type: TransactionTypeExpense, Children: []string{"Fuel", "Public transport", "Taxi"}},
+	{Name: "Shopping", Type: TransactionTypeExpense, Children: []string{"Clothing", "Electronics"}},
+	{Name: "Health", Type: TransactionTypeExpense},
+	{Name: "Entertainment", Type: TransactionTypeExpense},
+	{Name: "Salary", Type: TransactionTypeIncome},
+	{Name: "Other income", Type: TransactionTypeIncome},
+}
+
+// templateCategoryId shablondan yaratilgan kategoriya ID si. U foydalanuvchi va
+// kategoriya yo'liga bog'liq, shuning uchun parallel so'rovlar bir xil hujjatlarni
+// yaratadi va ikkinchisi duplicate key xatosi bilan to'xtaydi.
+func templateCategoryId(userId string, path ...string) string {
+	name := "category"
+	for _, part := range append([]string{userId}, path...) {
+		name += "/" + part
+	}
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(name)).String()
+}
+
+// templateCategories shablon bo'yicha foydalanuvchi kategoriyalari hujjatlari.
+func templateCategories(userId string, templates []CategoryTemplate, now time.Time) []interface{} {
+	var docs []interface{}
+	category := func(id, name, categoryType, parentId string) bson.D {
+		doc := bson.D{
+			{Key: "_id", Value: id},
+			{Key: "user_id", Value: userId},
+			{Key: "name", Value: name},
+			{Key: "type", Value: categoryType},
+		}
+		if parentId != "" {
+			doc = append(doc, bson.E{Key: "parent_id", Value: parentId})
+		}
+		return append(doc,
+			bson.E{Key: "created_at", Value: now},
+			bson.E{Key: "updated_at", Value: now},
+			bson.E{Key: "deleted_at", Value: nil},
+		)
+	}
+	for _, template := range templates {
+		parentId := templateCategoryId(userId, template.Name)
+		docs = append(docs, category(parentId, template.Name, template.Type, ""))
+		for _, child := range template.Children {
+			docs = append(docs, category(templateCategoryId(userId, template.Name, child), child, template.Type, parentId))
+		}
+	}
+	return docs
+}
+
+// seedDefaultCategories foydalanuvchida hech qachon kategoriya bo'lmagan bo'lsa
+// (o'chirilganlari ham hisobga olinadi), DefaultCategories ni yaratadi.
+func seedDefaultCategories(ctx context.Context, db *mongo.Database, userId string) error {
+	if userId == "" {
+		return nil
+	}
+	coll := db.Collection("categories")
+	count, err := coll.CountDocuments(ctx, bson.D{{Key: "user_id", Value: userId}}, options.Count().SetLimit(1))
+	if err != nil || count > 0 {
+		return err
+	}
+	_, err = coll.InsertMany(ctx, templateCategories(userId, DefaultCategories, time.Now()), options.InsertMany().SetOrdered(false))
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+	return nil
+}
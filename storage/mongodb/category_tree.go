@@ -0,0 +1,169 @@
+package mongodb
+
+import (
+	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
+	"context"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MaxCategoryDepth kategoriyalar daraxtining eng ko'p darajalari soni
+// (masalan: Oziq-ovqat > Restoranlar > Qahvaxonalar).
+const MaxCategoryDepth = 3
+
+// categoryTree foydalanuvchi kategoriyalarini parent_id bo'yicha daraxt qiladi.
+type categoryTree struct {
+	nodes    map[string]models.GetCategory
+	children map[string][]string
+}
+
+func newCategoryTree(categories []models.GetCategory) *categoryTree {
+	tree := &categoryTree{
+		nodes:    map[string]models.GetCategory{},
+		children: map[string][]string{},
+	}
+	for _, category := range categories {
+		tree.nodes[category.ID] = category
+	}
+	for _, category := range categories {
+		// Ota kategoriyasi topilmagan kategoriya yuqori darajada hisoblanadi
+		if _, ok := tree.nodes[category.ParentId]; ok {
+			tree.children[category.ParentId] = append(tree.children[category.ParentId], category.ID)
+		}
+	}
+	return tree
+}
+
+// loadCategoryTree foydalanuvchining o'chirilmagan kategoriyalari daraxti.
+func loadCategoryTree(ctx context.Context, db *mongo.Database, userId string) (*categoryTree, error) {
+	cursor, err := db.Collection("categories").Find(ctx, bson.D{
+		{Key: "user_id", Value: userId},
+		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var categories []models.GetCategory
+	if err := cursor.All(ctx, &categories); err != nil {
+		return nil, err
+	}
+	return newCategoryTree(categories), nil
+}
+
+// loadCategoryTreeOf categoryIds kategoriyalari va ularning ota kategoriyalari
+// daraxti. Umumiy hisob hisobotida kategoriyalar turli foydalanuvchilarniki bo'ladi.
+func loadCategoryTreeOf(ctx context.Context, db *mongo.Database, categoryIds []string) (*categoryTree, error) {
+	var categories []models.GetCategory
+	loaded := map[string]bool{}
+	ids := categoryIds
+	for depth := 0; depth < MaxCategoryDepth && len(ids) > 0; depth++ {
+		cursor, err := db.Collection("categories").Find(ctx, bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}},
+			{Key: "deleted_at", Value: nil},
+		})
+		if err != nil {
+			return nil, err
+		}
+		var level []models.GetCategory
+		if err := cursor.All(ctx, &level); err != nil {
+			return nil, err
+		}
+		ids = nil
+		for _, category := range level {
+			loaded[category.ID] = true
+			categories = append(categories, category)
+		}
+		for _, category := range level {
+			if category.ParentId != "" && !loaded[category.ParentId] {
+				loaded[category.ParentId] = true
+				ids = append(ids, category.ParentId)
+			}
+		}
+	}
+	return newCategoryTree(categories), nil
+}
+
+// subtree kategoriyaning o'zi va barcha ichki kategoriyalari.
+func (tree *categoryTree) subtree(id string) []string {
+	result := []string{id}
+	seen := map[string]bool{id: true}
+	for i := 0; i < len(result); i++ {
+		for _, child := range tree.children[result[i]] {
+			if !seen[child] {
+				seen[child] = true
+				result = append(result, child)
+			}
+		}
+	}
+	return result
+}
+
+// ancestors kategoriyaning ota kategoriyalari, eng yaqinidan boshlab.
+func (tree *categoryTree) ancestors(id string) []string {
+	var result []string
+	seen := map[string]bool{id: true}
+	for {
+		parent, ok := tree.nodes[tree.nodes[id].ParentId]
+		if !ok || seen[parent.ID] {
+			return result
+		}
+		seen[parent.ID] = true
+		result = append(result, parent.ID)
+		id = parent.ID
+	}
+}
+
+// depth kategoriya joylashgan daraja: yuqori darajadagi kategoriya uchun 1.
+func (tree *categoryTree) depth(id string) int {
+	return len(tree.ancestors(id)) + 1
+}
+
+// height kategoriya ostidagi darajalar soni, kategoriyaning o'zi bilan.
+func (tree *categoryTree) height(id string) int {
+	height := 1
+	for _, child := range tree.children[id] {
+		if h := tree.height(child) + 1; h > height {
+			height = h
+		}
+	}
+	return height
+}
+
+// checkParent height darajali id kategoriyasini (yangi kategoriya uchun id bo'sh)
+// parentId ostiga qo'yish mumkinligini tekshiradi: ota kategoriya mavjud, turi bir
+// xil, u id ning ichki kategoriyasi emas va daraxt MaxCategoryDepth dan oshmaydi.
+func (tree *categoryTree) checkParent(id, parentId, categoryType string, height int) error {
+	parent, ok := tree.nodes[parentId]
+	if !ok {
+		return errs.NotFound("parent category not found")
+	}
+	if !strings.EqualFold(parent.Type, categoryType) {
+		return errs.FailedPrecondition("parent category has a different type")
+	}
+	if id != "" {
+		for _, descendant := range tree.subtree(id) {
+			if descendant == parentId {
+				return errs.FailedPrecondition("category cannot be moved under itself")
+			}
+		}
+	}
+	if tree.depth(parentId)+height > MaxCategoryDepth {
+		return errs.FailedPrecondition("category tree is too deep")
+	}
+	return nil
+}
+
+// rollup har bir kategoriya summasini uning barcha ota kategoriyalariga ham qo'shadi.
+func (tree *categoryTree) rollup(amounts map[string]int64) map[string]int64 {
+	totals := map[string]int64{}
+	for id, amount := range amounts {
+		totals[id] += amount
+		for _, ancestor := range tree.ancestors(id) {
+			totals[ancestor] += amount
+		}
+	}
+	return totals
+}
@@ -0,0 +1,102 @@
+package mongodb
+
+import (
+	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func testCategoryTree() *categoryTree {
+	return newCategoryTree([]models.GetCategory{
+		{ID: "food", Type: "EXPENSE"},
+		{ID: "groceries", Type: "EXPENSE", ParentId: "food"},
+		{ID: "restaurants", Type: "EXPENSE", ParentId: "food"},
+		{ID: "coffee", Type: "EXPENSE", ParentId: "restaurants"},
+		{ID: "household", Type: "EXPENSE"},
+		{ID: "cleaning", Type: "EXPENSE", ParentId: "household"},
+		{ID: "salary", Type: "INCOME"},
+		// Ota kategoriyasi o'chirilgan
+		{ID: "orphan", Type: "EXPENSE", ParentId: "deleted"},
+	})
+}
+
+func TestCategoryTree(t *testing.T) {
+	tree := testCategoryTree()
+
+	assert.ElementsMatch(t, []string{"food", "groceries", "restaurants", "coffee"}, tree.subtree("food"))
+	assert.Equal(t, []string{"groceries"}, tree.subtree("groceries"))
+	assert.Equal(t, []string{"restaurants", "food"}, tree.ancestors("coffee"))
+	assert.Empty(t, tree.ancestors("orphan"))
+	assert.Empty(t, tree.ancestors("unknown"))
+
+	assert.Equal(t, 1, tree.depth("food"))
+	assert.Equal(t, 3, tree.depth("coffee"))
+	assert.Equal(t, 3, tree.height("food"))
+	assert.Equal(t, 1, tree.height("salary"))
+
+	totals := tree.rollup(map[string]int64{"groceries": 100, "coffee": 30, "restaurants": 20, "": 5})
+	assert.Equal(t, map[string]int64{"food": 150, "groceries": 100, "restaurants": 50, "coffee": 30, "": 5}, totals)
+}
+
+func TestCategoryTreeCheckParent(t *testing.T) {
+	tree := testCategoryTree()
+
+	assert.NoError(t, tree.checkParent("", "food", "expense", 1))
+	assert.NoError(t, tree.checkParent("orphan", "groceries", "EXPENSE", tree.height("orphan")))
+
+	tests := []struct {
+		id, parentId, categoryType string
+		kind                       errs.Kind
+	}{
+		{"", "missing", "EXPENSE", errs.KindNotFound},
+		{"", "salary", "EXPENSE", errs.KindFailedPrecondition},
+		{"food", "coffee", "EXPENSE", errs.KindFailedPrecondition},
+		{"", "coffee", "EXPENSE", errs.KindFailedPrecondition},
+		{"restaurants", "groceries", "EXPENSE", errs.KindFailedPrecondition},
+	}
+	for _, tt := range tests {
+		height := 1
+		if tt.id != "" {
+			height = tree.height(tt.id)
+		}
+		err := tree.checkParent(tt.id, tt.parentId, tt.categoryType, height)
+		assert.Equal(t, tt.kind, errs.KindOf(err), tt)
+	}
+}
+
+func TestCheckMerge(t *testing.T) {
+	tree := testCategoryTree()
+	category := func(id string) models.GetCategory { return tree.nodes[id] }
+
+	assert.NoError(t, checkMerge(tree, category("groceries"), category("restaurants")))
+	assert.NoError(t, checkMerge(tree, category("restaurants"), category("food")))
+	assert.NoError(t, checkMerge(tree, category("restaurants"), category("groceries")))
+
+	assert.Equal(t, errs.KindInvalidArgument, errs.KindOf(checkMerge(tree, category("food"), category("food"))))
+	assert.Equal(t, errs.KindFailedPrecondition, errs.KindOf(checkMerge(tree, category("food"), category("coffee"))))
+	assert.Equal(t, errs.KindFailedPrecondition, errs.KindOf(checkMerge(tree, category("groceries"), category("salary"))))
+	// food ning nevarasi cleaning ostida 4-darajaga tushadi
+	assert.Equal(t, errs.KindFailedPrecondition, errs.KindOf(checkMerge(tree, category("food"), category("cleaning"))))
+}
+
+func TestTemplateCategories(t *testing.T) {
+	docs := templateCategories("user-1", []CategoryTemplate{
+		{Name: "Food", Type: TransactionTypeExpense, Children: []string{"Groceries"}},
+		{Name: "Salary", Type: TransactionTypeIncome},
+	}, time.Now())
+	assert.Len(t, docs, 3)
+
+	food := docs[0].(bson.D)
+	groceries := docs[1].(bson.D)
+	assert.Equal(t, templateCategoryId("user-1", "Food"), food[0].Value)
+	assert.Equal(t, bson.E{Key: "parent_id", Value: food[0].Value}, groceries[4])
+
+	// ID foydalanuvchi va yo'lga bog'liq
+	assert.Equal(t, templateCategoryId("user-1", "Food"), templateCategoryId("user-1", "Food"))
+	assert.NotEqual(t, templateCategoryId("user-1", "Food"), templateCategoryId("user-2", "Food"))
+	assert.NotEqual(t, templateCategoryId("user-1", "Food", "Groceries"), templateCategoryId("user-1", "Groceries"))
+}
@@ -0,0 +1,99 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
+	"budgeting-service/pkg/exchange"
+	"context"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ExchangeRateRepository interface {
+	SetExchangeRates(ctx context.Context, request *pb.SetExchangeRatesReq) (*pb.SetExchangeRatesResp, error)
+	SaveRates(ctx context.Context, rates []models.ExchangeRate) error
+	GetRatesTable(ctx context.Context) (*exchange.Table, error)
+}
+
+type exchangeRateRepositoryImpl struct {
+	coll *mongo.Collection
+}
+
+func NewExchangeRateRepository(db *mongo.Database) ExchangeRateRepository {
+	return &exchangeRateRepositoryImpl{coll: db.Collection("exchange_rates")}
+}
+
+func (repo *exchangeRateRepositoryImpl) SetExchangeRates(ctx context.Context, request *pb.SetExchangeRatesReq) (*pb.SetExchangeRatesResp, error) {
+	var rates []models.ExchangeRate
+	for _, rate := range request.Rates {
+		date, err := parseDate("rates.date", exchange.DateLayout, rate.Date)
+		if err != nil {
+			return nil, err
+		}
+		if rate.Rate <= 0 {
+			return nil, errs.InvalidField("rates.rate", "exchange rate must be positive")
+		}
+		rates = append(rates, models.ExchangeRate{
+			FromCurrency: strings.ToUpper(rate.FromCurrency),
+			ToCurrency:   strings.ToUpper(rate.ToCurrency),
+			Rate:         rate.Rate,
+			Date:         date,
+		})
+	}
+
+	if err := repo.SaveRates(ctx, rates); err != nil {
+		return &pb.SetExchangeRatesResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	return &pb.SetExchangeRatesResp{
+		Status:  "success",
+		Message: "exchange rates saved successfully",
+	}, nil
+}
+
+// SaveRates kurslarni (from, to, date) kaliti bo'yicha yozadi, mavjud bo'lsa yangilaydi.
+func (repo *exchangeRateRepositoryImpl) SaveRates(ctx context.Context, rates []models.ExchangeRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	var writes []mongo.WriteModel
+	for _, rate := range rates {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{
+				{Key: "from_currency", Value: rate.FromCurrency},
+				{Key: "to_currency", Value: rate.ToCurrency},
+				{Key: "date", Value: rate.Date},
+			}).
+			SetUpdate(bson.D{
+				{Key: "$set", Value: bson.D{
+					{Key: "rate", Value: rate.Rate},
+					{Key: "updated_at", Value: time.Now()},
+				}},
+			}).
+			SetUpsert(true))
+	}
+
+	_, err := repo.coll.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+func (repo *exchangeRateRepositoryImpl) GetRatesTable(ctx context.Context) (*exchange.Table, error) {
+	cursor, err := repo.coll.Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+
+	var rates []models.ExchangeRate
+	if err := cursor.All(ctx, &rates); err != nil {
+		return nil, err
+	}
+	return exchange.NewTable(rates), nil
+}
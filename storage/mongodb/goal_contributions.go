@@ -0,0 +1,312 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Maqsad holatlari
+const (
+	GoalStatusInProgress = "in_progress"
+	GoalStatusCompleted  = "completed"
+)
+
+// Jamg'arma turlari
+const (
+	GoalContributionDeposit    = "contribution"
+	GoalContributionWithdrawal = "withdrawal"
+)
+
+var (
+	ErrInsufficientGoalBalance  = errs.FailedPrecondition("withdrawal exceeds goal current amount")
+	ErrGoalSavingsAccountNotSet = errs.FailedPrecondition("goal has no savings account")
+)
+
+// contribution ContributeToGoal va WithdrawFromGoal uchun umumiy so'rov.
+type contribution struct {
+	GoalId        string
+	UserId        string
+	Type          string
+	Amount        int64
+	AccountId     string
+	TransactionId string
+	Note          string
+	Date          string
+}
+
+func (repo *goalsRepositoryImpl) ContributeToGoal(ctx context.Context, request *pb.ContributeToGoalReq) (*pb.ContributeToGoalResp, error) {
+	result, goal, err := repo.recordContribution(ctx, contribution{
+		GoalId:        request.GoalId,
+		UserId:        request.UserId,
+		Type:          GoalContributionDeposit,
+		Amount:        request.Amount,
+		AccountId:     request.AccountId,
+		TransactionId: request.TransactionId,
+		Note:          request.Note,
+		Date:          request.Date,
+	})
+	if err != nil {
+		return &pb.ContributeToGoalResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	return &pb.ContributeToGoalResp{
+		Status:         "success",
+		Message:        "contribution added successfully",
+		ContributionId: result.ID,
+		CurrentAmount:  goal.CurrentAmount,
+		GoalStatus:     goal.Status,
+	}, nil
+}
+
+func (repo *goalsRepositoryImpl) WithdrawFromGoal(ctx context.Context, request *pb.WithdrawFromGoalReq) (*pb.WithdrawFromGoalResp, error) {
+	result, goal, err := repo.recordContribution(ctx, contribution{
+		GoalId:        request.GoalId,
+		UserId:        request.UserId,
+		Type:          GoalContributionWithdrawal,
+		Amount:        request.Amount,
+		AccountId:     request.AccountId,
+		TransactionId: request.TransactionId,
+		Note:          request.Note,
+		Date:          request.Date,
+	})
+	if err != nil {
+		return &pb.WithdrawFromGoalResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	return &pb.WithdrawFromGoalResp{
+		Status:         "success",
+		Message:        "withdrawal added successfully",
+		ContributionId: result.ID,
+		CurrentAmount:  goal.CurrentAmount,
+		GoalStatus:     goal.Status,
+	}, nil
+}
+
+// recordContribution jamg'armani yozadi va maqsadning current_amount va status
+// maydonlarini bitta tranzaksiyada yangilaydi. TransactionId berilsa, jamg'arma
+// mavjud tranzaksiyaga bog'lanadi; AccountId berilsa, pul shu hisob va maqsadning
+// jamg'arma hisobi orasida haqiqiy o'tkazma bilan ko'chiriladi.
+func (repo *goalsRepositoryImpl) recordContribution(ctx context.Context, request contribution) (*models.GoalContribution, *models.GetGoal, error) {
+	if request.Amount <= 0 {
+		return nil, nil, errs.InvalidField("amount", "must be positive")
+	}
+	date := time.Now()
+	if request.Date != "" {
+		var err error
+		date, err = parseDate("date", "2006-01-02 15:04:05", request.Date)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	result := models.GoalContribution{
+		ID:            uuid.NewString(),
+		GoalId:        request.GoalId,
+		Type:          request.Type,
+		Amount:        request.Amount,
+		AccountId:     request.AccountId,
+		TransactionId: request.TransactionId,
+		Note:          request.Note,
+		Date:          date,
+	}
+	var updated models.GetGoal
+
+	err := withTransaction(ctx, repo.db, func(sc mongo.SessionContext) error {
+		var goal models.GetGoal
+		if err := findOwned(sc, repo.coll, request.GoalId, request.UserId, ErrGoalNotFound, &goal); err != nil {
+			return err
+		}
+		result.UserId = goal.UserId
+		filter := ownedFilter(goal.ID, goal.UserId)
+
+		if request.TransactionId != "" {
+			if err := repo.checkLinkedTransaction(sc, goal, request.TransactionId); err != nil {
+				return err
+			}
+		} else if request.AccountId != "" {
+			transferId, err := repo.moveSavings(sc, goal, request, date)
+			if err != nil {
+				return err
+			}
+			result.TransferId = transferId
+		}
+
+		delta := request.Amount
+		if request.Type == GoalContributionWithdrawal {
+			delta = -delta
+			// Maqsadda yetarli mablag' bo'lishi shart
+			filter = append(filter, bson.E{Key: "current_amount", Value: bson.D{{Key: "$gte", Value: request.Amount}}})
+		}
+		err := repo.coll.FindOneAndUpdate(sc, filter, bson.D{
+			{Key: "$inc", Value: bson.D{{Key: "current_amount", Value: delta}}},
+			{Key: "$set", Value: bson.D{{Key: "updated_at", Value: time.Now()}}},
+		}, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&updated)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return ErrInsufficientGoalBalance
+		} else if err != nil {
+			return err
+		}
+
+		if status := goalStatus(updated.Status, updated.CurrentAmount, updated.TargetAmount); status != updated.Status {
+			_, err := repo.coll.UpdateOne(sc, bson.D{{Key: "_id", Value: updated.ID}}, bson.D{
+				{Key: "$set", Value: bson.D{{Key: "status", Value: status}}},
+			})
+			if err != nil {
+				return err
+			}
+			updated.Status = status
+		}
+
+		_, err = repo.contributions.InsertOne(sc, result)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return &result, &updated, nil
+}
+
+// checkLinkedTransaction tranzaksiya foydalanuvchiga tegishli ekanini va boshqa
+// jamg'armaga bog'lanmaganini tekshiradi.
+func (repo *goalsRepositoryImpl) checkLinkedTransaction(sc mongo.SessionContext, goal models.GetGoal, transactionId string) error {
+	err := repo.transactions.coll.FindOne(sc, bson.D{
+		{Key: "_id", Value: transactionId},
+		{Key: "user_id", Value: goal.UserId},
+		{Key: "deleted_at", Value: nil},
+	}).Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return ErrTransactionNotFound
+	} else if err != nil {
+		return err
+	}
+
+	count, err := repo.contributions.CountDocuments(sc, bson.D{{Key: "transaction_id", Value: transactionId}})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errs.AlreadyExists("transaction is already linked to a goal contribution")
+	}
+	return nil
+}
+
+// moveSavings jamg'armada hisob va maqsadning jamg'arma hisobi orasida o'tkazma
+// yaratadi: kiritishda hisobdan jamg'arma hisobiga, yechishda aksincha.
+func (repo *goalsRepositoryImpl) moveSavings(sc mongo.SessionContext, goal models.GetGoal, request contribution, date time.Time) (string, error) {
+	if goal.SavingsAccountId == "" {
+		return "", ErrGoalSavingsAccountNotSet
+	}
+	// Maqsad summalari asosiy valyutada, shuning uchun jamg'arma hisobi ham shu valyutada bo'lishi kerak
+	savings, err := checkAccountRole(sc, repo.db, goal.SavingsAccountId, goal.UserId, AccountRoleEditor)
+	if err != nil {
+		return "", err
+	}
+	baseCurrency, err := NewUserSettingsRepository(repo.db).GetBaseCurrency(sc, goal.UserId)
+	if err != nil {
+		return "", err
+	}
+	if savings.Currency != baseCurrency {
+		return "", errs.FailedPrecondition(fmt.Sprintf("savings account currency %s does not match base currency %s", savings.Currency, baseCurrency))
+	}
+
+	transfer := &pb.CreateTransferReq{
+		UserId:        goal.UserId,
+		FromAccountId: request.AccountId,
+		ToAccountId:   goal.SavingsAccountId,
+		Amount:        request.Amount,
+		Description:   "Goal contribution: " + goal.Name,
+	}
+	if request.Type == GoalContributionWithdrawal {
+		transfer.FromAccountId, transfer.ToAccountId = goal.SavingsAccountId, request.AccountId
+		transfer.Description = "Goal withdrawal: " + goal.Name
+	}
+	return repo.transactions.transfer(sc, transfer, date)
+}
+
+func (repo *goalsRepositoryImpl) GetGoalContributions(ctx context.Context, request *pb.GetGoalContributionsReq) (*pb.GetGoalContributionsResp, error) {
+	if err := findOwned(ctx, repo.coll, request.GoalId, request.UserId, ErrGoalNotFound, nil); err != nil {
+		return nil, err
+	}
+	filter := bson.D{{Key: "goal_id", Value: request.GoalId}}
+
+	totalCount, err := repo.contributions.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "date", Value: -1}}).
+		SetSkip((request.Page - 1) * request.Limit).
+		SetLimit(request.Limit)
+	cursor, err := repo.contributions.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var contributions []models.GoalContribution
+	if err := cursor.All(ctx, &contributions); err != nil {
+		return nil, err
+	}
+	if len(contributions) == 0 {
+		return nil, errs.NotFound("goal contributions not found")
+	}
+
+	var results []*pb.GoalContribution
+	for _, c := range contributions {
+		results = append(results, &pb.GoalContribution{
+			Id:            c.ID,
+			GoalId:        c.GoalId,
+			UserId:        c.UserId,
+			Type:          c.Type,
+			Amount:        c.Amount,
+			AccountId:     c.AccountId,
+			TransactionId: c.TransactionId,
+			TransferId:    c.TransferId,
+			Note:          c.Note,
+			Date:          c.Date.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &pb.GetGoalContributionsResp{
+		Contributions: results,
+		TotalCount:    totalCount,
+		Page:          request.Page,
+		Limit:         request.Limit,
+	}, nil
+}
+
+// checkSavingsAccount maqsadga bog'lanadigan jamg'arma hisobida foydalanuvchi
+// kamida editor ekanini tekshiradi.
+func (repo *goalsRepositoryImpl) checkSavingsAccount(ctx context.Context, userId, accountId string) error {
+	if accountId == "" {
+		return nil
+	}
+	_, err := checkAccountRole(ctx, repo.db, accountId, userId, AccountRoleEditor)
+	return err
+}
+
+// goalStatus jamg'arilgan summaga qarab maqsad holatini aniqlaydi: maqsadga
+// yetilganda completed, undan keyin pul yechilsa yana in_progress.
+func goalStatus(status string, current, target int64) string {
+	if target > 0 && current >= target {
+		return GoalStatusCompleted
+	}
+	if status == "" || status == GoalStatusCompleted {
+		return GoalStatusInProgress
+	}
+	return status
+}
@@ -0,0 +1,71 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoalStatus(t *testing.T) {
+	assert.Equal(t, GoalStatusInProgress, goalStatus("", 0, 1000))
+	assert.Equal(t, GoalStatusCompleted, goalStatus(GoalStatusInProgress, 1000, 1000))
+	assert.Equal(t, GoalStatusInProgress, goalStatus(GoalStatusCompleted, 999, 1000))
+	assert.Equal(t, "paused", goalStatus("paused", 10, 1000))
+}
+
+func TestContributeToGoal(t *testing.T) {
+	db, err := ConnectToMongoDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Client().Disconnect(context.Background())
+
+	repo := NewGoalsRepository(db)
+	_, err = repo.CreateGoal(context.Background(), &pb.CreateGoalReq{
+		UserId:       "test_user_id",
+		Name:         "Contribution Goal",
+		TargetAmount: 100000,
+		Deadline:     "2030-12-31 00:00:00",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	goals, err := repo.GetGoalsList(context.Background(), &pb.GetGoalsReq{
+		UserId: "test_user_id",
+		Name:   "Contribution Goal",
+		Page:   1,
+		Limit:  1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	goalId := goals.Goals[0].Id
+
+	contributed, err := repo.ContributeToGoal(context.Background(), &pb.ContributeToGoalReq{
+		GoalId: goalId,
+		Amount: 100000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(100000), contributed.CurrentAmount)
+	assert.Equal(t, GoalStatusCompleted, contributed.GoalStatus)
+
+	withdrawn, err := repo.WithdrawFromGoal(context.Background(), &pb.WithdrawFromGoalReq{
+		GoalId: goalId,
+		Amount: 40000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(60000), withdrawn.CurrentAmount)
+	assert.Equal(t, GoalStatusInProgress, withdrawn.GoalStatus)
+
+	_, err = repo.WithdrawFromGoal(context.Background(), &pb.WithdrawFromGoalReq{
+		GoalId: goalId,
+		Amount: 60001,
+	})
+	assert.ErrorIs(t, err, ErrInsufficientGoalBalance)
+}
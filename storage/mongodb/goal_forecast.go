@@ -0,0 +1,247 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/models"
+	"budgeting-service/pkg/money"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Prognoz oxirgi shuncha oydagi jamg'armalar va sof daromadga asoslanadi
+const forecastLookbackMonths = 6
+
+const NotificationTypeGoalAtRisk = "goal_at_risk"
+
+type goalForecast struct {
+	Remaining           int64
+	AverageContribution int64
+	AverageNetIncome    int64
+	// Nol qiymat - hozirgi sur'atda maqsadga yetib bo'lmaydi
+	ProjectedCompletion time.Time
+	RequiredMonthly     int64
+	AtRisk              bool
+}
+
+// forecastGoal maqsadning bajarilish sanasini va muddatga ulgurish uchun oylik
+// kerakli summani hisoblaydi. Sur'at sifatida oxirgi davrdagi o'rtacha oylik
+// jamg'arma olinadi; jamg'arma bo'lmasa, o'rtacha oylik sof daromad olinadi.
+// contributions va netIncome oxirgi forecastLookbackMonths oyga tegishli bo'lishi kerak.
+func forecastGoal(goal models.GetGoal, contributions []models.GoalContribution, netIncome int64, now time.Time) goalForecast {
+	var contributed int64
+	for _, c := range contributions {
+		if c.Type == GoalContributionWithdrawal {
+			contributed -= c.Amount
+		} else {
+			contributed += c.Amount
+		}
+	}
+
+	forecast := goalForecast{
+		AverageContribution: contributed / forecastLookbackMonths,
+		AverageNetIncome:    netIncome / forecastLookbackMonths,
+	}
+	if goal.TargetAmount > goal.CurrentAmount {
+		forecast.Remaining = goal.TargetAmount - goal.CurrentAmount
+	}
+	if forecast.Remaining == 0 {
+		forecast.ProjectedCompletion = now
+		return forecast
+	}
+
+	rate := forecast.AverageContribution
+	if rate <= 0 {
+		rate = forecast.AverageNetIncome
+	}
+	if rate > 0 {
+		forecast.ProjectedCompletion = now.AddDate(0, int(ceilDiv(forecast.Remaining, rate)), 0)
+	}
+
+	if months := monthsUntil(now, goal.Deadline); months > 0 {
+		forecast.RequiredMonthly = ceilDiv(forecast.Remaining, int64(months))
+	} else {
+		forecast.RequiredMonthly = forecast.Remaining
+	}
+	forecast.AtRisk = forecast.ProjectedCompletion.IsZero() || forecast.ProjectedCompletion.After(goal.Deadline)
+	return forecast
+}
+
+// monthsUntil now dan deadline gacha bo'lgan oylar soni (yuqoriga yaxlitlangan).
+func monthsUntil(now, deadline time.Time) int {
+	months := 0
+	for now.AddDate(0, months, 0).Before(deadline) {
+		months++
+	}
+	return months
+}
+
+func ceilDiv(a, b int64) int64 {
+	return (a + b - 1) / b
+}
+
+func (repo *reportingRepositoryImpl) ForecastGoal(ctx context.Context, request *pb.ForecastGoalReq) (*pb.ForecastGoalResp, error) {
+	var goal models.GetGoal
+	if err := findOwned(ctx, repo.db.Collection("goals"), request.GoalId, request.UserId, ErrGoalNotFound, &goal); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	netIncome, err := repo.netIncome(ctx, goal.UserId, now.AddDate(0, -forecastLookbackMonths, 0))
+	if err != nil {
+		return nil, err
+	}
+	forecast, err := repo.forecast(ctx, goal, netIncome, now)
+	if err != nil {
+		return nil, err
+	}
+	baseCurrency, err := repo.userSettings.GetBaseCurrency(ctx, goal.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ForecastGoalResp{
+		GoalId:                      goal.ID,
+		TargetAmount:                goal.TargetAmount,
+		CurrentAmount:               goal.CurrentAmount,
+		RemainingAmount:             forecast.Remaining,
+		Deadline:                    goal.Deadline.Format("2006-01-02 15:04:05"),
+		AverageMonthlyContribution:  forecast.AverageContribution,
+		AverageMonthlyNetIncome:     forecast.AverageNetIncome,
+		ProjectedCompletionDate:     formatProjection(forecast.ProjectedCompletion),
+		RequiredMonthlyContribution: forecast.RequiredMonthly,
+		AtRisk:                      forecast.AtRisk,
+		BaseCurrency:                baseCurrency,
+	}, nil
+}
+
+// GetGoalsInProgress at_risk holati tekshiriladigan bajarilmagan maqsadlar.
+func (repo *reportingRepositoryImpl) GetGoalsInProgress(ctx context.Context) ([]models.GetGoal, error) {
+	cursor, err := repo.db.Collection("goals").Find(ctx, bson.D{
+		{Key: "status", Value: GoalStatusInProgress},
+		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var goals []models.GetGoal
+	if err := cursor.All(ctx, &goals); err != nil {
+		return nil, err
+	}
+	return goals, nil
+}
+
+// CheckGoalAtRisk maqsadni prognoz qiladi va u muddatdan ortda qola boshlagan
+// bo'lsa, bitta bildirishnoma yuboradi.
+func (repo *reportingRepositoryImpl) CheckGoalAtRisk(ctx context.Context, goal models.GetGoal, now time.Time) error {
+	netIncome, err := repo.netIncome(ctx, goal.UserId, now.AddDate(0, -forecastLookbackMonths, 0))
+	if err != nil {
+		return err
+	}
+	forecast, err := repo.forecast(ctx, goal, netIncome, now)
+	if err != nil {
+		return err
+	}
+	return repo.markGoalRisk(ctx, goal, forecast)
+}
+
+// forecast maqsad prognozini hisoblaydi. Hech narsa yozmaydi: at_risk holati
+// faqat CheckGoalAtRisk da saqlanadi.
+func (repo *reportingRepositoryImpl) forecast(ctx context.Context, goal models.GetGoal, netIncome int64, now time.Time) (goalForecast, error) {
+	cursor, err := repo.db.Collection("goal_contributions").Find(ctx, bson.D{
+		{Key: "goal_id", Value: goal.ID},
+		{Key: "date", Value: bson.D{{Key: "$gte", Value: now.AddDate(0, -forecastLookbackMonths, 0)}}},
+	})
+	if err != nil {
+		return goalForecast{}, err
+	}
+	var contributions []models.GoalContribution
+	if err := cursor.All(ctx, &contributions); err != nil {
+		return goalForecast{}, err
+	}
+
+	return forecastGoal(goal, contributions, netIncome, now), nil
+}
+
+// markGoalRisk at_risk holati o'zgargan bo'lsa, uni saqlaydi; maqsad ortda qola
+// boshlaganda bildirishnoma ham yuboriladi.
+func (repo *reportingRepositoryImpl) markGoalRisk(ctx context.Context, goal models.GetGoal, forecast goalForecast) error {
+	if forecast.AtRisk == goal.AtRisk || goal.Status != GoalStatusInProgress {
+		return nil
+	}
+
+	return withTransaction(ctx, repo.db, func(sc mongo.SessionContext) error {
+		res, err := repo.db.Collection("goals").UpdateOne(sc, bson.D{
+			{Key: "_id", Value: goal.ID},
+			{Key: "at_risk", Value: bson.D{{Key: "$ne", Value: forecast.AtRisk}}},
+		}, bson.D{{Key: "$set", Value: bson.D{{Key: "at_risk", Value: forecast.AtRisk}}}})
+		if err != nil || res.ModifiedCount == 0 || !forecast.AtRisk {
+			return err
+		}
+
+		baseCurrency, err := repo.userSettings.GetBaseCurrency(sc, goal.UserId)
+		if err != nil {
+			return err
+		}
+		_, err = repo.db.Collection("notifications").InsertOne(sc, bson.D{
+			{Key: "_id", Value: uuid.NewString()},
+			{Key: "user_id", Value: goal.UserId},
+			{Key: "message", Value: fmt.Sprintf("Goal %q is off track: save %s per month to reach it by %s",
+				goal.Name, money.Format(forecast.RequiredMonthly, baseCurrency), goal.Deadline.Format("2006-01-02"))},
+			{Key: "type", Value: NotificationTypeGoalAtRisk},
+			{Key: "status", Value: "sent"},
+			{Key: "goal_id", Value: goal.ID},
+			{Key: "is_read", Value: false},
+			{Key: "created_at", Value: time.Now()},
+			{Key: "updated_at", Value: time.Now()},
+			{Key: "deleted_at", Value: nil},
+		})
+		return err
+	})
+}
+
+// netIncome from dan beri foydalanuvchining sof daromadi (income - expense) asosiy valyutada.
+func (repo *reportingRepositoryImpl) netIncome(ctx context.Context, userId string, from time.Time) (int64, error) {
+	transactions, err := repo.reportTransactions(ctx, bson.D{
+		{Key: "user_id", Value: userId},
+		{Key: "type", Value: bson.D{{Key: "$in", Value: bson.A{TransactionTypeIncome, TransactionTypeExpense}}}},
+		{Key: "date", Value: bson.D{{Key: "$gte", Value: from}}},
+		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		return 0, err
+	}
+	baseCurrency, table, err := repo.converter(ctx, userId)
+	if err != nil {
+		return 0, err
+	}
+
+	var income, expense []models.ReportTransaction
+	for _, transaction := range transactions {
+		if transaction.Type == TransactionTypeIncome {
+			income = append(income, transaction)
+		} else {
+			expense = append(expense, transaction)
+		}
+	}
+	incomeTotal, err := convertTotal(income, table, baseCurrency)
+	if err != nil {
+		return 0, err
+	}
+	expenseTotal, err := convertTotal(expense, table, baseCurrency)
+	if err != nil {
+		return 0, err
+	}
+	return incomeTotal - expenseTotal, nil
+}
+
+func formatProjection(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+	return date.Format("2006-01-02 15:04:05")
+}
@@ -0,0 +1,64 @@
+package mongodb
+
+import (
+	"budgeting-service/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForecastGoal(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	goal := models.GetGoal{
+		TargetAmount:  120000,
+		CurrentAmount: 60000,
+		Deadline:      time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Oyiga 10000 jamg'arilsa, qolgan 60000 aynan muddatda yig'iladi
+	onTrack := forecastGoal(goal, []models.GoalContribution{
+		{Type: GoalContributionDeposit, Amount: 70000},
+		{Type: GoalContributionWithdrawal, Amount: 10000},
+	}, 0, now)
+	assert.Equal(t, int64(60000), onTrack.Remaining)
+	assert.Equal(t, int64(10000), onTrack.AverageContribution)
+	assert.Equal(t, time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), onTrack.ProjectedCompletion)
+	assert.Equal(t, int64(10000), onTrack.RequiredMonthly)
+	assert.False(t, onTrack.AtRisk)
+
+	atRisk := forecastGoal(goal, []models.GoalContribution{
+		{Type: GoalContributionDeposit, Amount: 30000},
+	}, 0, now)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), atRisk.ProjectedCompletion)
+	assert.True(t, atRisk.AtRisk)
+
+	// Jamg'arma bo'lmasa, sof daromad sur'at sifatida olinadi
+	fallback := forecastGoal(goal, nil, 120000, now)
+	assert.Equal(t, int64(20000), fallback.AverageNetIncome)
+	assert.Equal(t, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), fallback.ProjectedCompletion)
+	assert.False(t, fallback.AtRisk)
+
+	stalled := forecastGoal(goal, nil, -5000, now)
+	assert.True(t, stalled.ProjectedCompletion.IsZero())
+	assert.True(t, stalled.AtRisk)
+
+	goal.CurrentAmount = goal.TargetAmount
+	done := forecastGoal(goal, nil, 0, now)
+	assert.Equal(t, int64(0), done.Remaining)
+	assert.Equal(t, now, done.ProjectedCompletion)
+	assert.False(t, done.AtRisk)
+}
+
+func TestMonthsUntil(t *testing.T) {
+	now := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, 0, monthsUntil(now, now.AddDate(0, 0, -1)))
+	assert.Equal(t, 1, monthsUntil(now, now.AddDate(0, 0, 1)))
+	assert.Equal(t, 3, monthsUntil(now, time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 4, monthsUntil(now, time.Date(2024, 4, 16, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestCeilDiv(t *testing.T) {
+	assert.Equal(t, int64(3), ceilDiv(9, 3))
+	assert.Equal(t, int64(4), ceilDiv(10, 3))
+}
@@ -3,8 +3,8 @@ package mongodb
 import (
 	pb "budgeting-service/generated/budgeting"
 	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
@@ -19,39 +19,77 @@ type GoalsRepository interface {
 	DeleteGoal(ctx context.Context, request *pb.DeleteGoalReq) (*pb.DeleteGoalResp, error)
 	GetGoal(ctx context.Context, request *pb.GetGoalReq) (*pb.GetGoalResp, error)
 	GetGoalsList(ctx context.Context, request *pb.GetGoalsReq) (*pb.GetGoalsResp, error)
+	// Maqsadga jamg'arish
+	ContributeToGoal(ctx context.Context, request *pb.ContributeToGoalReq) (*pb.ContributeToGoalResp, error)
+	WithdrawFromGoal(ctx context.Context, request *pb.WithdrawFromGoalReq) (*pb.WithdrawFromGoalResp, error)
+	GetGoalContributions(ctx context.Context, request *pb.GetGoalContributionsReq) (*pb.GetGoalContributionsResp, error)
 }
 
 type goalsRepositoryImpl struct {
-	coll *mongo.Collection
+	db            *mongo.Database
+	coll          *mongo.Collection
+	contributions *mongo.Collection
+	transactions  *transactionRepositoryImpl
 }
 
 func NewGoalsRepository(db *mongo.Database) GoalsRepository {
-	return &goalsRepositoryImpl{coll: db.Collection("goals")}
+	return &goalsRepositoryImpl{
+		db:            db,
+		coll:          db.Collection("goals"),
+		contributions: db.Collection("goal_contributions"),
+		transactions:  NewTransactionRepository(db).(*transactionRepositoryImpl),
+	}
 }
 
 func (repo *goalsRepositoryImpl) CreateGoal(ctx context.Context, goal *pb.CreateGoalReq) (*pb.CreateGoalResp, error) {
-	deadline, err := time.Parse("2006-01-02 15:04:05", goal.Deadline)
+	deadline, err := parseDate("deadline", "2006-01-02 15:04:05", goal.Deadline)
 	if err != nil {
 		return nil, err
 	}
-	_, err = repo.coll.InsertOne(ctx, bson.D{
-		{Key: "_id", Value: uuid.NewString()},
-		{Key: "user_id", Value: goal.UserId},
-		{Key: "name", Value: goal.Name},
-		{Key: "target_amount", Value: goal.TargetAmount},
-		{Key: "current_amount", Value: 0},
-		{Key: "deadline", Value: deadline},
-		{Key: "status", Value: goal.Status},
-		{Key: "created_at", Value: time.Now()},
-		{Key: "updated_at", Value: time.Now()},
-		{Key: "deleted_at", Value: nil},
+	if goal.CurrentAmount < 0 {
+		return nil, errs.InvalidField("current_amount", "can not be negative")
+	}
+
+	goalId := uuid.NewString()
+	err = withTransaction(ctx, repo.db, func(sc mongo.SessionContext) error {
+		if err := repo.checkSavingsAccount(sc, goal.UserId, goal.SavingsAccountId); err != nil {
+			return err
+		}
+		_, err := repo.coll.InsertOne(sc, bson.D{
+			{Key: "_id", Value: goalId},
+			{Key: "user_id", Value: goal.UserId},
+			{Key: "name", Value: goal.Name},
+			{Key: "target_amount", Value: goal.TargetAmount},
+			{Key: "current_amount", Value: goal.CurrentAmount},
+			{Key: "deadline", Value: deadline},
+			{Key: "status", Value: goalStatus(goal.Status, goal.CurrentAmount, goal.TargetAmount)},
+			{Key: "savings_account_id", Value: goal.SavingsAccountId},
+			{Key: "created_at", Value: time.Now()},
+			{Key: "updated_at", Value: time.Now()},
+			{Key: "deleted_at", Value: nil},
+		})
+		if err != nil || goal.CurrentAmount == 0 {
+			return err
+		}
+		// Boshlang'ich summa ham jamg'arma sifatida yoziladi, shunda
+		// current_amount doim jamg'armalar yig'indisiga teng bo'ladi
+		_, err = repo.contributions.InsertOne(sc, models.GoalContribution{
+			ID:     uuid.NewString(),
+			GoalId: goalId,
+			UserId: goal.UserId,
+			Type:   GoalContributionDeposit,
+			Amount: goal.CurrentAmount,
+			Note:   "opening balance",
+			Date:   time.Now(),
+		})
+		return err
 	})
 
 	if err != nil {
 		return &pb.CreateGoalResp{
 			Status:  "error",
 			Message: err.Error(),
-		}, nil
+		}, err
 	}
 
 	return &pb.CreateGoalResp{
@@ -61,34 +99,51 @@ func (repo *goalsRepositoryImpl) CreateGoal(ctx context.Context, goal *pb.Create
 }
 
 func (repo *goalsRepositoryImpl) UpdateGoal(ctx context.Context, goal *pb.UpdateGoalReq) (*pb.UpdateGoalResp, error) {
-	filter := bson.D{
-		{Key: "_id", Value: goal.Id},
-		{Key: "deleted_at", Value: nil},
+	deadline, err := parseDate("deadline", "2006-01-02 15:04:05", goal.Deadline)
+	if err != nil {
+		return nil, err
+	}
+	err = findOwned(ctx, repo.coll, goal.Id, goal.UserId, ErrGoalNotFound, nil)
+	if err == nil {
+		err = repo.checkSavingsAccount(ctx, goal.UserId, goal.SavingsAccountId)
+	}
+	if err != nil {
+		return &pb.UpdateGoalResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
 	}
 
 	update := bson.D{
 		{Key: "$set", Value: bson.D{
 			{Key: "name", Value: goal.Name},
 			{Key: "target_amount", Value: goal.TargetAmount},
-			{Key: "deadline", Value: goal.Deadline},
+			{Key: "deadline", Value: deadline},
 			{Key: "status", Value: goal.Status},
+			{Key: "savings_account_id", Value: goal.SavingsAccountId},
 			{Key: "updated_at", Value: time.Now()},
 		}},
 	}
 
-	res, err := repo.coll.UpdateOne(ctx, filter, update)
+	_, err = repo.coll.UpdateOne(ctx, ownedFilter(goal.Id, goal.UserId), update)
 	if err != nil {
 		return &pb.UpdateGoalResp{
 			Status:  "error",
 			Message: "Error updating goal: " + err.Error(),
-		}, nil
+		}, err
 	}
 
-	if res.ModifiedCount == 0 {
+	// Maqsad summasi kamaytirilgan bo'lsa, maqsad allaqachon bajarilgan bo'lishi mumkin
+	_, err = repo.coll.UpdateOne(ctx, bson.D{
+		{Key: "_id", Value: goal.Id},
+		{Key: "target_amount", Value: bson.D{{Key: "$gt", Value: 0}}},
+		{Key: "$expr", Value: bson.D{{Key: "$gte", Value: bson.A{"$current_amount", "$target_amount"}}}},
+	}, bson.D{{Key: "$set", Value: bson.D{{Key: "status", Value: GoalStatusCompleted}}}})
+	if err != nil {
 		return &pb.UpdateGoalResp{
 			Status:  "error",
-			Message: "Goal not found",
-		}, nil
+			Message: "Error updating goal: " + err.Error(),
+		}, err
 	}
 
 	return &pb.UpdateGoalResp{
@@ -98,12 +153,14 @@ func (repo *goalsRepositoryImpl) UpdateGoal(ctx context.Context, goal *pb.Update
 }
 
 func (repo *goalsRepositoryImpl) DeleteGoal(ctx context.Context, request *pb.DeleteGoalReq) (*pb.DeleteGoalResp, error) {
-	filter := bson.D{
-		{Key: "_id", Value: request.Id},
-		{Key: "deleted_at", Value: nil},
+	if err := findOwned(ctx, repo.coll, request.Id, request.UserId, ErrGoalNotFound, nil); err != nil {
+		return &pb.DeleteGoalResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
 	}
 
-	res, err := repo.coll.UpdateOne(ctx, filter, bson.D{
+	_, err := repo.coll.UpdateOne(ctx, ownedFilter(request.Id, request.UserId), bson.D{
 		{Key: "$set", Value: bson.D{{Key: "deleted_at", Value: time.Now()}}},
 	})
 
@@ -111,14 +168,7 @@ func (repo *goalsRepositoryImpl) DeleteGoal(ctx context.Context, request *pb.Del
 		return &pb.DeleteGoalResp{
 			Status:  "error",
 			Message: "Error deleting goal: " + err.Error(),
-		}, nil
-	}
-
-	if res.ModifiedCount == 0 {
-		return &pb.DeleteGoalResp{
-			Status:  "error",
-			Message: "Goal not found",
-		}, nil
+		}, err
 	}
 
 	return &pb.DeleteGoalResp{
@@ -128,52 +178,52 @@ func (repo *goalsRepositoryImpl) DeleteGoal(ctx context.Context, request *pb.Del
 }
 
 func (repo *goalsRepositoryImpl) GetGoal(ctx context.Context, request *pb.GetGoalReq) (*pb.GetGoalResp, error) {
-	filter := bson.D{
-		{Key: "_id", Value: request.Id},
-		{Key: "deleted_at", Value: nil},
-	}
-
 	goal := models.GetGoal{}
-	err := repo.coll.FindOne(ctx, filter).Decode(&goal)
-	if err == mongo.ErrNoDocuments {
-		return nil, errors.New("goal not found")
-	} else if err != nil {
+	if err := findOwned(ctx, repo.coll, request.Id, request.UserId, ErrGoalNotFound, &goal); err != nil {
 		return nil, err
 	}
 
 	fmt.Println(goal)
 	return &pb.GetGoalResp{
-		Id:            goal.ID,
-		UserId:        goal.UserId,
-		Name:          goal.Name,
-		TargetAmount:  goal.TargetAmount,
-		CurrentAmount: goal.CurrentAmount,
-		Deadline:      goal.Deadline.Format("2006-01-02 15:04:05"),
-		Status:        goal.Status,
+		Id:               goal.ID,
+		UserId:           goal.UserId,
+		Name:             goal.Name,
+		TargetAmount:     goal.TargetAmount,
+		CurrentAmount:    goal.CurrentAmount,
+		Deadline:         goal.Deadline.Format("2006-01-02 15:04:05"),
+		Status:           goal.Status,
+		SavingsAccountId: goal.SavingsAccountId,
 	}, nil
 }
 
 func (repo *goalsRepositoryImpl) GetGoalsList(ctx context.Context, request *pb.GetGoalsReq) (*pb.GetGoalsResp, error) {
 	pipeline := createGoalFilters(request)
+	page := listPage{field: "created_at", page: request.Page, limit: request.Limit, token: request.PageToken}
 
-	countPipeline := append(pipeline, bson.D{{Key: "$count", Value: "totalCount"}})
-	countCursor, err := repo.coll.Aggregate(ctx, countPipeline)
-	if err != nil {
-		return nil, err
-	}
+	totalCount := int32(0)
+	if !page.cursorMode() {
+		countPipeline := append(mongo.Pipeline{}, pipeline...)
+		countPipeline = append(countPipeline, bson.D{{Key: "$count", Value: "totalCount"}})
+		countCursor, err := repo.coll.Aggregate(ctx, countPipeline)
+		if err != nil {
+			return nil, err
+		}
 
-	var countResult []bson.M
-	if err = countCursor.All(ctx, &countResult); err != nil {
-		return nil, err
-	}
+		var countResult []bson.M
+		if err = countCursor.All(ctx, &countResult); err != nil {
+			return nil, err
+		}
 
-	totalCount := int32(0)
-	if len(countResult) > 0 {
-		totalCount = countResult[0]["totalCount"].(int32)
+		if len(countResult) > 0 {
+			totalCount = countResult[0]["totalCount"].(int32)
+		}
 	}
 
-	pipeline = append(pipeline, bson.D{{Key: "$skip", Value: (request.Page - 1) * request.Limit}})
-	pipeline = append(pipeline, bson.D{{Key: "$limit", Value: request.Limit}})
+	stages, err := page.stages()
+	if err != nil {
+		return nil, err
+	}
+	pipeline = append(pipeline, stages...)
 
 	cursor, err := repo.coll.Aggregate(ctx, pipeline)
 	if err != nil {
@@ -181,53 +231,51 @@ func (repo *goalsRepositoryImpl) GetGoalsList(ctx context.Context, request *pb.G
 	}
 	defer cursor.Close(ctx)
 
-	var goals []*pb.Goal
-	for cursor.Next(ctx) {
-		var goal models.GetGoal
-		err := cursor.Decode(&goal)
-		if err != nil {
-			return nil, err
-		}
+	var items []models.GetGoal
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, err
+	}
+	n, more := page.trim(len(items))
 
+	var goals []*pb.Goal
+	for _, goal := range items[:n] {
 		goals = append(goals, &pb.Goal{
-			Id:            goal.ID,
-			UserId:        goal.UserId,
-			Name:          goal.Name,
-			TargetAmount:  goal.TargetAmount,
-			CurrentAmount: goal.CurrentAmount,
-			Deadline:      goal.Deadline.Format("2006-01-02 15:04:05"),
-			Status:        goal.Status,
+			Id:               goal.ID,
+			UserId:           goal.UserId,
+			Name:             goal.Name,
+			TargetAmount:     goal.TargetAmount,
+			CurrentAmount:    goal.CurrentAmount,
+			Deadline:         goal.Deadline.Format("2006-01-02 15:04:05"),
+			Status:           goal.Status,
+			SavingsAccountId: goal.SavingsAccountId,
 		})
 	}
 
 	if len(goals) == 0 {
-		return nil, mongo.ErrNoDocuments
+		return nil, errs.NotFound("goals not found")
 	}
 
-	return &pb.GetGoalsResp{
+	resp := &pb.GetGoalsResp{
 		Goals:      goals,
 		TotalCount: int64(totalCount),
 		Page:       request.Page,
 		Limit:      request.Limit,
-	}, nil
+	}
+	if more {
+		last := items[n-1]
+		resp.NextPageToken = page.nextToken(last.CreatedAt.UnixMilli(), last.ID)
+	}
+	return resp, nil
 }
 
 func createGoalFilters(request *pb.GetGoalsReq) mongo.Pipeline {
-	pipeline := mongo.Pipeline{}
-	if request.UserId != "" {
-		pipeline = append(pipeline, bson.D{
-			{Key: "$match", Value: bson.D{
-				{Key: "user_id", Value: request.UserId},
-			}},
-		})
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.D{{Key: "user_id", Value: request.UserId}}}},
 	}
 	if request.Name != "" {
 		pipeline = append(pipeline, bson.D{
 			{Key: "$match", Value: bson.D{
-				{Key: "name", Value: bson.D{
-					{Key: "$regex", Value: ".*" + request.Name + ".*"},
-					{Key: "$options", Value: "i"},
-				}},
+				{Key: "name", Value: containsRegex(request.Name)},
 			}},
 		})
 	}
@@ -46,6 +46,7 @@ func TestUpdateGoal(t *testing.T) {
         TargetAmount: 2000,
         Deadline:     "2023-01-31",
         Status:       "INPORGRESS",
+        UserId: "test_user_id",
     }
 
     resp, err := repo.UpdateGoal(context.Background(), goal)
@@ -66,6 +67,7 @@ func TestDeleteGoal(t *testing.T) {
 
 	request := &pb.DeleteGoalReq{
         Id: "f5764263-bddf-4289-b4b1-ecc2e5e0931a",
+        UserId: "test_user_id",
     }
 	resp, err := repo.DeleteGoal(context.Background(), request)
 	if err!= nil {
@@ -84,6 +86,7 @@ func TestGetGoal(t *testing.T) {
 
     request := &pb.GetGoalReq{
         Id: "5e8ad7a5-fd85-44dd-bb7a-3087a5b9c7e5",
+        UserId: "test_user_id",
     }
     resp, err := repo.GetGoal(context.Background(), request)
     if err!= nil {
@@ -0,0 +1,57 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// EnsureIndexes servis ishlashi uchun kerakli indekslarni yaratadi.
+// Mavjud indekslar qayta yaratilmaydi, shuning uchun har ishga tushishda chaqirish xavfsiz.
+func EnsureIndexes(ctx context.Context, db *mongo.Database) error {
+	indexes := []struct {
+		collection string
+		model      mongo.IndexModel
+	}{
+		// Tranzaksiyalarni description bo'yicha to'liq matnli qidirish ($text)
+		{"transactions", mongo.IndexModel{
+			Keys:    bson.D{{Key: "description", Value: "text"}},
+			Options: options.Index().SetName("transactions_description_text"),
+		}},
+		// Ro'yxatlarni sana bo'yicha saralash va sahifalash
+		{"transactions", mongo.IndexModel{
+			Keys:    bson.D{{Key: "account_id", Value: 1}, {Key: "date", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("transactions_account_date"),
+		}},
+		// Idempotency kaliti foydalanuvchi doirasida yagona; kalitsiz tranzaksiyalar indeksga kirmaydi
+		{"transactions", mongo.IndexModel{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "idempotency_key", Value: 1}},
+			Options: options.Index().SetName("transactions_idempotency_key").SetUnique(true).
+				SetPartialFilterExpression(bson.D{{Key: "idempotency_key", Value: bson.D{{Key: "$type", Value: "string"}}}}),
+		}},
+		// Ehtimoliy dublikatlar ro'yxati
+		{"suspected_duplicates", mongo.IndexModel{
+			Keys:    bson.D{{Key: "account_id", Value: 1}, {Key: "status", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("suspected_duplicates_account_status"),
+		}},
+		// Kategoriya bolalarini topish (ko'chirish, qo'shish, o'chirish)
+		{"categories", mongo.IndexModel{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "parent_id", Value: 1}},
+			Options: options.Index().SetName("categories_user_parent"),
+		}},
+		{"category_rules", mongo.IndexModel{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "priority", Value: 1}},
+			Options: options.Index().SetName("category_rules_user_priority"),
+		}},
+	}
+
+	for _, index := range indexes {
+		if _, err := db.Collection(index.collection).Indexes().CreateOne(ctx, index.model); err != nil {
+			return fmt.Errorf("%s: %w", index.collection, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/models"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Journal yozuvlari (postings) uchun konstantalar
+const (
+	LedgerAccount  = "account"
+	LedgerCategory = "category"
+	LedgerTransfer = "transfer"
+
+	SideDebit  = "debit"
+	SideCredit = "credit"
+)
+
+type JournalRepository interface {
+	PostTransaction(ctx context.Context, transaction models.GetTransaction, currency string) error
+	VoidTransaction(ctx context.Context, transactionId string) error
+	GetTrialBalance(ctx context.Context, request *pb.GetTrialBalanceReq) (*pb.GetTrialBalanceResp, error)
+}
+
+type journalRepositoryImpl struct {
+	coll *mongo.Collection
+}
+
+func NewJournalRepository(db *mongo.Database) JournalRepository {
+	return &journalRepositoryImpl{coll: db.Collection("journal")}
+}
+
+// PostTransaction tranzaksiyani ikki tomonlama yozuv sifatida saqlaydi:
+// expense - kategoriya debit, hisob credit; income - hisob debit, kategoriya credit.
+// O'tkazmalarda kategoriya o'rniga transfer hisobi (transfer_id) ishlatiladi,
+// shuning uchun har bir valyuta bo'yicha yozuvlar alohida balanslanadi.
+func (repo *journalRepositoryImpl) PostTransaction(ctx context.Context, transaction models.GetTransaction, currency string) error {
+	postings, err := transactionPostings(transaction, currency)
+	if err != nil {
+		return err
+	}
+
+	var docs []interface{}
+	for _, posting := range postings {
+		docs = append(docs, bson.D{
+			{Key: "_id", Value: uuid.NewString()},
+			{Key: "user_id", Value: posting.UserId},
+			{Key: "transaction_id", Value: posting.TransactionId},
+			{Key: "ledger", Value: posting.Ledger},
+			{Key: "ledger_id", Value: posting.LedgerId},
+			{Key: "side", Value: posting.Side},
+			{Key: "amount", Value: posting.Amount},
+			{Key: "currency", Value: posting.Currency},
+			{Key: "date", Value: posting.Date},
+			{Key: "created_at", Value: time.Now()},
+			{Key: "deleted_at", Value: nil},
+		})
+	}
+
+	_, err = repo.coll.InsertMany(ctx, docs)
+	return err
+}
+
+func (repo *journalRepositoryImpl) VoidTransaction(ctx context.Context, transactionId string) error {
+	filter := bson.D{
+		{Key: "transaction_id", Value: transactionId},
+		{Key: "deleted_at", Value: nil},
+	}
+
+	_, err := repo.coll.UpdateMany(ctx, filter, bson.D{
+		{Key: "$set", Value: bson.D{{Key: "deleted_at", Value: time.Now()}}},
+	})
+	return err
+}
+
+func (repo *journalRepositoryImpl) GetTrialBalance(ctx context.Context, request *pb.GetTrialBalanceReq) (*pb.GetTrialBalanceResp, error) {
+	pipeline := mongo.Pipeline{
+		bson.D{{
+			Key: "$match", Value: bson.D{
+				{Key: "user_id", Value: request.UserId},
+				{Key: "deleted_at", Value: nil},
+			},
+		}},
+		bson.D{{
+			Key: "$group", Value: bson.D{
+				{Key: "_id", Value: "$currency"},
+				{Key: "total_debit", Value: bson.D{{Key: "$sum", Value: bson.D{
+					{Key: "$cond", Value: bson.A{bson.D{{Key: "$eq", Value: bson.A{"$side", SideDebit}}}, "$amount", 0}},
+				}}}},
+				{Key: "total_credit", Value: bson.D{{Key: "$sum", Value: bson.D{
+					{Key: "$cond", Value: bson.A{bson.D{{Key: "$eq", Value: bson.A{"$side", SideCredit}}}, "$amount", 0}},
+				}}}},
+			},
+		}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+	}
+
+	cursor, err := repo.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	balanced := true
+	var balances []*pb.TrialBalance
+	for cursor.Next(ctx) {
+		var result models.TrialBalance
+		if err := cursor.Decode(&result); err != nil {
+			return nil, err
+		}
+		difference := result.TotalDebit - result.TotalCredit
+		if difference != 0 {
+			balanced = false
+		}
+		balances = append(balances, &pb.TrialBalance{
+			Currency:    result.Currency,
+			TotalDebit:  result.TotalDebit,
+			TotalCredit: result.TotalCredit,
+			Difference:  difference,
+		})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetTrialBalanceResp{
+		UserId:   request.UserId,
+		Balances: balances,
+		Balanced: balanced,
+	}, nil
+}
+
+func transactionPostings(transaction models.GetTransaction, currency string) ([]models.Posting, error) {
+	account := models.Posting{
+		UserId:        transaction.UserId,
+		TransactionId: transaction.Id,
+		Ledger:        LedgerAccount,
+		LedgerId:      transaction.AccountId,
+		Amount:        transaction.Amount,
+		Currency:      currency,
+		Date:          transaction.Date,
+	}
+	category := account
+	category.Ledger = LedgerCategory
+	category.LedgerId = transaction.CategoryId
+	if isTransfer(transaction.Type) {
+		category.Ledger = LedgerTransfer
+		category.LedgerId = transaction.TransferId
+	}
+
+	switch transaction.Type {
+	case TransactionTypeIncome, TransactionTypeTransferIn:
+		account.Side, category.Side = SideDebit, SideCredit
+	case TransactionTypeExpense, TransactionTypeTransferOut:
+		account.Side, category.Side = SideCredit, SideDebit
+	default:
+		return nil, fmt.Errorf("invalid transaction type: %s", transaction.Type)
+	}
+
+	if len(transaction.Splits) == 0 {
+		return []models.Posting{account, category}, nil
+	}
+	// Bo'lingan tranzaksiyada har bir kategoriyaga o'z qismi yoziladi
+	postings := []models.Posting{account}
+	for _, split := range transaction.Splits {
+		line := category
+		line.LedgerId = split.CategoryId
+		line.Amount = split.Amount
+		postings = append(postings, line)
+	}
+	return postings, nil
+}
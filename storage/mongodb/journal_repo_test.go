@@ -0,0 +1,85 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/models"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionPostings(t *testing.T) {
+	transaction := models.GetTransaction{
+		Id:         "test_transaction_id",
+		AccountId:  "test_account_id",
+		UserId:     "test_user_id",
+		CategoryId: "test_category",
+		Type:       TransactionTypeExpense,
+		Amount:     10000,
+		Date:       time.Now(),
+	}
+
+	postings, err := transactionPostings(transaction, "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(postings))
+	assert.Equal(t, LedgerAccount, postings[0].Ledger)
+	assert.Equal(t, SideCredit, postings[0].Side)
+	assert.Equal(t, LedgerCategory, postings[1].Ledger)
+	assert.Equal(t, SideDebit, postings[1].Side)
+	assert.Equal(t, postings[0].Amount, postings[1].Amount)
+
+	transaction.Type = TransactionTypeIncome
+	postings, err = transactionPostings(transaction, "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, SideDebit, postings[0].Side)
+	assert.Equal(t, SideCredit, postings[1].Side)
+
+	transaction.Type = "unknown"
+	_, err = transactionPostings(transaction, "USD")
+	assert.Error(t, err)
+}
+
+func TestSplitTransactionPostings(t *testing.T) {
+	postings, err := transactionPostings(models.GetTransaction{
+		Id:        "test_transaction_id",
+		AccountId: "test_account_id",
+		Type:      TransactionTypeExpense,
+		Amount:    10000,
+		Splits: []models.Split{
+			{CategoryId: "groceries", Amount: 7000},
+			{CategoryId: "household", Amount: 3000},
+		},
+	}, "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, len(postings))
+	assert.Equal(t, int64(10000), postings[0].Amount)
+	assert.Equal(t, "groceries", postings[1].LedgerId)
+	assert.Equal(t, int64(7000), postings[1].Amount)
+	assert.Equal(t, "household", postings[2].LedgerId)
+	assert.Equal(t, SideDebit, postings[2].Side)
+}
+
+func TestGetTrialBalance(t *testing.T) {
+	db, err := ConnectToMongoDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Client().Disconnect(context.Background())
+
+	repo := NewJournalRepository(db)
+	resp, err := repo.GetTrialBalance(context.Background(), &pb.GetTrialBalanceReq{
+		UserId: "test_user_id",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, resp.Balanced)
+}
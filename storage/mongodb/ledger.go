@@ -0,0 +1,100 @@
+package mongodb
+
+import (
+	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Tranzaksiya turlari
+const (
+	TransactionTypeIncome      = "income"
+	TransactionTypeExpense     = "expense"
+	TransactionTypeTransferIn  = "transfer_in"
+	TransactionTypeTransferOut = "transfer_out"
+)
+
+// withTransaction fn ni bitta MongoDB sessiya tranzaksiyasi ichida bajaradi.
+// fn ichidagi barcha yozuvlar yoki birga saqlanadi, yoki birga bekor qilinadi.
+func withTransaction(ctx context.Context, db *mongo.Database, fn func(sc mongo.SessionContext) error) error {
+	session, err := db.Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	})
+	return err
+}
+
+// signedAmount tranzaksiyaning hisob balansiga ta'sirini qaytaradi:
+// income balansni oshiradi, expense esa kamaytiradi.
+func signedAmount(transactionType string, amount int64) (int64, error) {
+	switch transactionType {
+	case TransactionTypeIncome, TransactionTypeTransferIn:
+		return amount, nil
+	case TransactionTypeExpense, TransactionTypeTransferOut:
+		return -amount, nil
+	default:
+		return 0, errs.InvalidField("type", "invalid transaction type: "+transactionType)
+	}
+}
+
+func isTransfer(transactionType string) bool {
+	return transactionType == TransactionTypeTransferIn || transactionType == TransactionTypeTransferOut
+}
+
+// transferRate o'tkazma kursini aniqlaydi. Valyutalar bir xil bo'lsa kurs 1 ga teng,
+// aks holda so'rovda aniq kurs berilishi shart.
+func transferRate(fromCurrency, toCurrency string, rate float64) (float64, error) {
+	if fromCurrency == toCurrency {
+		if rate != 0 && rate != 1 {
+			return 0, errs.InvalidField("rate", "exchange rate is not allowed for same currency transfer")
+		}
+		return 1, nil
+	}
+	if rate <= 0 {
+		return 0, errs.InvalidField("rate", fmt.Sprintf("exchange rate is required for %s to %s transfer", fromCurrency, toCurrency))
+	}
+	return rate, nil
+}
+
+// parseDate so'rovdagi sanani layout bo'yicha o'qiydi. Noto'g'ri formatda
+// field maydoni uchun InvalidArgument qaytaradi.
+func parseDate(field, layout, value string) (time.Time, error) {
+	date, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, errs.InvalidField(field, "must be in "+layout+" format")
+	}
+	return date, nil
+}
+
+// adjustBalance hisob balansini delta qiymatiga o'zgartiradi ($inc)
+// va yangilangan hisobni qaytaradi.
+func adjustBalance(ctx context.Context, accounts *mongo.Collection, accountId string, delta int64) (*models.GetAccount, error) {
+	filter := bson.D{
+		{Key: "_id", Value: accountId},
+		{Key: "deleted_at", Value: nil},
+	}
+
+	var account models.GetAccount
+	err := accounts.FindOneAndUpdate(ctx, filter, bson.D{
+		{Key: "$inc", Value: bson.D{{Key: "balance", Value: delta}}},
+		{Key: "$set", Value: bson.D{{Key: "updated_at", Value: time.Now()}}},
+	}, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&account)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrAccountNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
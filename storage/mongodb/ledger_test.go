@@ -0,0 +1,115 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/models"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestSignedAmount(t *testing.T) {
+	income, err := signedAmount(TransactionTypeIncome, 15000)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(15000), income)
+
+	expense, err := signedAmount(TransactionTypeExpense, 15000)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-15000), expense)
+
+	_, err = signedAmount("transfer", 150)
+	assert.Error(t, err)
+}
+
+func TestTransactionLedgerBalance(t *testing.T) {
+	db, err := ConnectToMongoDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Client().Disconnect(context.Background())
+
+	accountId := uuid.NewString()
+	_, err = db.Collection("accounts").InsertOne(context.Background(), bson.D{
+		{Key: "_id", Value: accountId},
+		{Key: "user_id", Value: "test_user_id"},
+		{Key: "name", Value: "Ledger Account"},
+		{Key: "balance", Value: int64(100000)},
+		{Key: "currency", Value: "USD"},
+		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := NewTransactionRepository(db)
+	_, err = repo.CreateTransaction(context.Background(), &pb.CreateTransactionReq{
+		UserId:    "test_user_id",
+		AccountId: accountId,
+		Amount:    25000,
+		Type:      TransactionTypeExpense,
+		Date:      "2024-01-01 00:00:00",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var transaction models.GetTransaction
+	err = db.Collection("transactions").FindOne(context.Background(), bson.D{{Key: "account_id", Value: accountId}}).Decode(&transaction)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account, err := NewAccountRepository(db).GetAccount(context.Background(), &pb.GetAccountReq{Id: accountId, UserId: "test_user_id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(75000), account.Balance)
+
+	_, err = repo.UpdateTransaction(context.Background(), &pb.UpdateTransactionReq{
+		Id:     transaction.Id,
+		Amount: 10000,
+		Type:   TransactionTypeIncome,
+		Date:   "2024-01-01 00:00:00",
+		UserId: "test_user_id",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	account, err = NewAccountRepository(db).GetAccount(context.Background(), &pb.GetAccountReq{Id: accountId, UserId: "test_user_id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(110000), account.Balance)
+
+	_, err = repo.DeleteTransaction(context.Background(), &pb.DeleteTransactionReq{
+		Id:     transaction.Id,
+		UserId: "test_user_id",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	account, err = NewAccountRepository(db).GetAccount(context.Background(), &pb.GetAccountReq{Id: accountId, UserId: "test_user_id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(100000), account.Balance)
+}
+
+func TestTransferRate(t *testing.T) {
+	rate, err := transferRate("USD", "USD", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, rate)
+
+	_, err = transferRate("USD", "USD", 1.5)
+	assert.Error(t, err)
+
+	_, err = transferRate("USD", "UZS", 0)
+	assert.Error(t, err)
+
+	rate, err = transferRate("USD", "UZS", 12650)
+	assert.NoError(t, err)
+	assert.Equal(t, 12650.0, rate)
+}
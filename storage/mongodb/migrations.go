@@ -0,0 +1,103 @@
+package mongodb
+
+import (
+	"budgeting-service/pkg/money"
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MigrateMoneyToMinorUnits eski hujjatlardagi float64 summalarni valyutaning
+// minor units (int64) ko'rinishiga o'tkazadi. Faqat hali double turida saqlangan
+// maydonlar o'zgartiriladi, shuning uchun qayta ishga tushirish xavfsiz.
+func MigrateMoneyToMinorUnits(ctx context.Context, db *mongo.Database) error {
+	accountCurrencies := map[string]string{}
+	accountCurrency := func(doc bson.M) (string, error) {
+		accountId, _ := doc["account_id"].(string)
+		if currency, ok := accountCurrencies[accountId]; ok {
+			return currency, nil
+		}
+		var account struct {
+			Currency string `bson:"currency"`
+		}
+		err := db.Collection("accounts").FindOne(ctx, bson.D{{Key: "_id", Value: accountId}}).Decode(&account)
+		if err != nil && err != mongo.ErrNoDocuments {
+			return "", err
+		}
+		accountCurrencies[accountId] = account.Currency
+		return account.Currency, nil
+	}
+
+	userSettings := NewUserSettingsRepository(db)
+	baseCurrency := func(doc bson.M) (string, error) {
+		userId, _ := doc["user_id"].(string)
+		return userSettings.GetBaseCurrency(ctx, userId)
+	}
+
+	ownCurrency := func(doc bson.M) (string, error) {
+		currency, _ := doc["currency"].(string)
+		return currency, nil
+	}
+
+	migrations := []struct {
+		collection string
+		field      string
+		currency   func(doc bson.M) (string, error)
+	}{
+		{"accounts", "balance", ownCurrency},
+		{"transactions", "amount", accountCurrency},
+		{"journal", "amount", ownCurrency},
+		{"budgets", "amount", baseCurrency},
+		{"goals", "target_amount", baseCurrency},
+		{"goals", "current_amount", baseCurrency},
+	}
+
+	for _, migration := range migrations {
+		err := migrateMoneyField(ctx, db.Collection(migration.collection), migration.field, migration.currency)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", migration.collection, migration.field, err)
+		}
+	}
+	return nil
+}
+
+func migrateMoneyField(ctx context.Context, coll *mongo.Collection, field string, currencyOf func(doc bson.M) (string, error)) error {
+	cursor, err := coll.Find(ctx, bson.D{{Key: field, Value: bson.D{{Key: "$type", Value: "double"}}}})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	var writes []mongo.WriteModel
+	for cursor.Next(ctx) {
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+		currency, err := currencyOf(doc)
+		if err != nil {
+			return err
+		}
+		value, _ := doc[field].(float64)
+		amount, err := money.FromFloat(value, currency)
+		if err != nil {
+			return fmt.Errorf("document %v: %w", doc["_id"], err)
+		}
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: "_id", Value: doc["_id"]}}).
+			SetUpdate(bson.D{{Key: "$set", Value: bson.D{
+				{Key: field, Value: amount},
+			}}}))
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+	if len(writes) == 0 {
+		return nil
+	}
+
+	_, err = coll.BulkWrite(ctx, writes)
+	return err
+}
@@ -43,7 +43,7 @@ func (repo *notificationRepositoryImpl) SendNotification(ctx context.Context, no
 		return &pb.SendNotificationResp{
 			Status:  "error",
 			Message: err.Error(),
-		}, nil
+		}, err
 	}
 	return &pb.SendNotificationResp{
 		Status:  "success",
@@ -52,15 +52,8 @@ func (repo *notificationRepositoryImpl) SendNotification(ctx context.Context, no
 }
 
 func (repo *notificationRepositoryImpl) GetNotification(ctx context.Context, notification *pb.GetNotificationReq) (*pb.GetNotificationResp, error) {
-	filter := bson.D{
-		{Key: "_id", Value: notification.Id},
-		{Key: "deleted_at", Value: nil},
-	}
-
 	var notifcation models.Notification
-	err := repo.coll.FindOne(ctx, filter).Decode(&notifcation)
-
-	if err != nil {
+	if err := findOwned(ctx, repo.coll, notification.Id, notification.UserId, ErrNotificationNotFound, &notifcation); err != nil {
 		return nil, err
 	}
 	return &pb.GetNotificationResp{
@@ -73,16 +66,19 @@ func (repo *notificationRepositoryImpl) GetNotification(ctx context.Context, not
 }
 
 func (repo *notificationRepositoryImpl) DeleteNotification(ctx context.Context, notification *pb.DeleteNotificationReq) (*pb.DeleteNotificationResp, error) {
-	filter := bson.D{
-		{Key: "_id", Value: notification.Id},
-		{Key: "deleted_at", Value: nil},
+	if err := findOwned(ctx, repo.coll, notification.Id, notification.UserId, ErrNotificationNotFound, nil); err != nil {
+		return &pb.DeleteNotificationResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
 	}
+	filter := ownedFilter(notification.Id, notification.UserId)
 	_, err := repo.coll.UpdateOne(ctx, filter, bson.D{{Key: "$set", Value: bson.D{{Key: "deleted_at", Value: time.Now()}}}})
 	if err != nil {
 		return &pb.DeleteNotificationResp{
 			Status:  "error",
 			Message: err.Error(),
-		}, nil
+		}, err
 	}
 	return &pb.DeleteNotificationResp{
 		Status:  "success",
@@ -91,10 +87,13 @@ func (repo *notificationRepositoryImpl) DeleteNotification(ctx context.Context,
 }
 
 func (repo *notificationRepositoryImpl) UpdateNotification(ctx context.Context, notification *pb.UpdateNotificationReq) (*pb.UpdateNotificationResp, error) {
-	filter := bson.D{
-		{Key: "_id", Value: notification.Id},
-		{Key: "deleted_at", Value: nil},
+	if err := findOwned(ctx, repo.coll, notification.Id, notification.UserId, ErrNotificationNotFound, nil); err != nil {
+		return &pb.UpdateNotificationResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
 	}
+	filter := ownedFilter(notification.Id, notification.UserId)
 
 	update := bson.D{
 		{Key: "$set", Value: bson.D{
@@ -108,7 +107,7 @@ func (repo *notificationRepositoryImpl) UpdateNotification(ctx context.Context,
 		return &pb.UpdateNotificationResp{
 			Status:  "error",
 			Message: err.Error(),
-		}, nil
+		}, err
 	}
 	return &pb.UpdateNotificationResp{
 		Status:  "success",
@@ -122,19 +121,28 @@ func (repo *notificationRepositoryImpl) GetNotificationsList(ctx context.Context
 		{Key: "deleted_at", Value: nil},
 	}
 
-	var notifcations []*pb.Notification
-	cursor, err := repo.coll.Find(ctx, filter)
+	// Bildirishnomalarda faqat cursor rejimi bor: eng yangilari birinchi
+	page := listPage{field: "created_at", limit: notification.Limit, token: notification.PageToken}
+	stages, err := page.stages()
+	if err != nil {
+		return nil, err
+	}
+	pipeline := append(mongo.Pipeline{{{Key: "$match", Value: filter}}}, stages...)
+
+	cursor, err := repo.coll.Aggregate(ctx, pipeline)
 	if err != nil {
 		return nil, err
 	}
 	defer cursor.Close(ctx)
 
-	for cursor.Next(ctx) {
-		var notifcation models.Notification
-		err := cursor.Decode(&notifcation)
-		if err != nil {
-			return nil, err
-		}
+	var items []models.Notification
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, err
+	}
+	n, more := page.trim(len(items))
+
+	var notifcations []*pb.Notification
+	for _, notifcation := range items[:n] {
 		notifcations = append(notifcations, &pb.Notification{
 			Id:        notifcation.ID,
 			Message:   notifcation.Message,
@@ -143,10 +151,14 @@ func (repo *notificationRepositoryImpl) GetNotificationsList(ctx context.Context
 			IsRead:    notifcation.IsRead,
 			CreatedAt: notifcation.CreatedAt.Format("2006-01-02 15:04:05"),
 		})
-
 	}
 
-	return &pb.GetNotificationsListResp{
+	resp := &pb.GetNotificationsListResp{
 		NotificationList: notifcations,
-	}, nil
+	}
+	if more {
+		last := items[n-1]
+		resp.NextPageToken = page.nextToken(last.CreatedAt.UnixMilli(), last.ID)
+	}
+	return resp, nil
 }
@@ -35,7 +35,8 @@ func TestGetNotification(t *testing.T) {
 	defer db.Client().Disconnect(context.Background())
 	repo := NewNotificationRepository(db)
 	resp, err := repo.GetNotification(context.Background(), &pb.GetNotificationReq{
-		Id: "462dd3da-cb47-44d0-b693-462ebb971aec",
+		Id:     "462dd3da-cb47-44d0-b693-462ebb971aec",
+		UserId: "test_user_id",
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -51,7 +52,8 @@ func TestDeleteNotification(t *testing.T) {
 	defer db.Client().Disconnect(context.Background())
 	repo := NewNotificationRepository(db)
 	resp, err := repo.DeleteNotification(context.Background(), &pb.DeleteNotificationReq{
-		Id: "462dd3da-cb47-44d0-b693-462ebb971aec",
+		Id:     "462dd3da-cb47-44d0-b693-462ebb971aec",
+		UserId: "test_user_id",
 	})
 	if err != nil {
 		t.Fatal(err)
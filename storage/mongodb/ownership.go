@@ -0,0 +1,61 @@
+package mongodb
+
+import (
+	"budgeting-service/pkg/errs"
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Foydalanuvchi ma'lumotlariga kirish xatolari. Mijozga NotFound/PermissionDenied
+// bo'lib qaytadi.
+var (
+	ErrPermissionDenied             = errs.PermissionDenied("permission denied")
+	ErrAccountNotFound              = errs.NotFound("account not found")
+	ErrTransactionNotFound          = errs.NotFound("transaction not found")
+	ErrCategoryNotFound             = errs.NotFound("category not found")
+	ErrBudgetNotFound               = errs.NotFound("budget not found")
+	ErrGoalNotFound                 = errs.NotFound("goal not found")
+	ErrNotificationNotFound         = errs.NotFound("notification not found")
+	ErrRecurringTransactionNotFound = errs.NotFound("recurring transaction not found")
+	ErrCategoryRuleNotFound         = errs.NotFound("category rule not found")
+)
+
+// findOwned id bo'yicha o'chirilmagan hujjatni topadi va u userId ga tegishli
+// ekanini tekshiradi. Hujjat yo'q bo'lsa notFound, boshqa foydalanuvchiniki
+// bo'lsa ErrPermissionDenied qaytariladi. result nil bo'lmasa, hujjat unga yoziladi.
+func findOwned(ctx context.Context, coll *mongo.Collection, id, userId string, notFound error, result interface{}) error {
+	if userId == "" {
+		return ErrPermissionDenied
+	}
+
+	var doc bson.Raw
+	err := coll.FindOne(ctx, bson.D{
+		{Key: "_id", Value: id},
+		{Key: "deleted_at", Value: nil},
+	}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return notFound
+	} else if err != nil {
+		return err
+	}
+
+	if owner, _ := doc.Lookup("user_id").StringValueOK(); owner != userId {
+		return ErrPermissionDenied
+	}
+	if result == nil {
+		return nil
+	}
+	return bson.Unmarshal(doc, result)
+}
+
+// ownedFilter id va egasi bo'yicha o'chirilmagan hujjat filtri.
+func ownedFilter(id, userId string) bson.D {
+	return bson.D{
+		{Key: "_id", Value: id},
+		{Key: "user_id", Value: userId},
+		{Key: "deleted_at", Value: nil},
+	}
+}
@@ -0,0 +1,173 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAccessErrorCodes(t *testing.T) {
+	assert.Equal(t, codes.PermissionDenied, status.Code(ErrPermissionDenied))
+	assert.Equal(t, codes.NotFound, status.Code(ErrGoalNotFound))
+	assert.Equal(t, "goal not found", ErrGoalNotFound.Error())
+}
+
+func TestCrossUserAccess(t *testing.T) {
+	db, err := ConnectToMongoDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Client().Disconnect(context.Background())
+	ctx := context.Background()
+
+	// Har bir kolleksiyada owner_user ga tegishli bitta hujjat
+	ids := map[string]string{}
+	for _, coll := range []string{"accounts", "transactions", "categories", "budgets", "goals", "notifications", "recurring_transactions"} {
+		ids[coll] = uuid.NewString()
+		_, err := db.Collection(coll).InsertOne(ctx, bson.D{
+			{Key: "_id", Value: ids[coll]},
+			{Key: "user_id", Value: "owner_user"},
+			{Key: "account_id", Value: ids["accounts"]},
+			{Key: "type", Value: TransactionTypeExpense},
+			{Key: "amount", Value: int64(1000)},
+			{Key: "balance", Value: int64(1000)},
+			{Key: "currency", Value: "USD"},
+			{Key: "date", Value: time.Now()},
+			{Key: "deleted_at", Value: nil},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	accounts := NewAccountRepository(db)
+	transactions := NewTransactionRepository(db)
+	categories := NewCategoryRepository(db)
+	budgets := NewBudgetManagementRepo(db)
+	goals := NewGoalsRepository(db)
+	notifications := NewNotificationRepository(db)
+	recurring := NewRecurringTransactionRepository(db)
+
+	calls := map[string]func(userId string) error{
+		"GetAccount": func(userId string) error {
+			_, err := accounts.GetAccount(ctx, &pb.GetAccountReq{Id: ids["accounts"], UserId: userId})
+			return err
+		},
+		"UpdateAccount": func(userId string) error {
+			_, err := accounts.UpdateAccount(ctx, &pb.UpdateAccountReq{Id: ids["accounts"], UserId: userId, Name: "stolen"})
+			return err
+		},
+		"DeleteAccount": func(userId string) error {
+			_, err := accounts.DeleteAccount(ctx, &pb.DeleteAccountReq{Id: ids["accounts"], UserId: userId})
+			return err
+		},
+		"CreateTransaction": func(userId string) error {
+			_, err := transactions.CreateTransaction(ctx, &pb.CreateTransactionReq{
+				AccountId: ids["accounts"], UserId: userId, Type: TransactionTypeExpense, Amount: 100, Date: "2024-01-01 00:00:00",
+			})
+			return err
+		},
+		"GetTransaction": func(userId string) error {
+			_, err := transactions.GetTransaction(ctx, &pb.GetTransactionReq{Id: ids["transactions"], UserId: userId})
+			return err
+		},
+		"UpdateTransaction": func(userId string) error {
+			_, err := transactions.UpdateTransaction(ctx, &pb.UpdateTransactionReq{
+				Id: ids["transactions"], UserId: userId, Type: TransactionTypeExpense, Amount: 1, Date: "2024-01-01 00:00:00",
+			})
+			return err
+		},
+		"DeleteTransaction": func(userId string) error {
+			_, err := transactions.DeleteTransaction(ctx, &pb.DeleteTransactionReq{Id: ids["transactions"], UserId: userId})
+			return err
+		},
+		"GetCategory": func(userId string) error {
+			_, err := categories.GetCategory(ctx, &pb.GetCategoryReq{Id: ids["categories"], UserId: userId})
+			return err
+		},
+		"UpdateCategory": func(userId string) error {
+			_, err := categories.UpdateCategory(ctx, &pb.UpdateCategoryReq{Id: ids["categories"], UserId: userId, Name: "stolen"})
+			return err
+		},
+		"DeleteCategory": func(userId string) error {
+			_, err := categories.DeleteCategory(ctx, &pb.DeleteCategoryReq{Id: ids["categories"], UserId: userId})
+			return err
+		},
+		"GetBudget": func(userId string) error {
+			_, err := budgets.GetBudget(ctx, &pb.GetBudgetReq{Id: ids["budgets"], UserId: userId})
+			return err
+		},
+		"UpdateBudget": func(userId string) error {
+			_, err := budgets.UpdateBudget(ctx, &pb.UpdateBudgetReq{Id: ids["budgets"], UserId: userId, Amount: 1})
+			return err
+		},
+		"DeleteBudget": func(userId string) error {
+			_, err := budgets.DeleteBudget(ctx, &pb.DeleteBudgetReq{Id: ids["budgets"], UserId: userId})
+			return err
+		},
+		"GetGoal": func(userId string) error {
+			_, err := goals.GetGoal(ctx, &pb.GetGoalReq{Id: ids["goals"], UserId: userId})
+			return err
+		},
+		"UpdateGoal": func(userId string) error {
+			_, err := goals.UpdateGoal(ctx, &pb.UpdateGoalReq{Id: ids["goals"], UserId: userId, Name: "stolen"})
+			return err
+		},
+		"DeleteGoal": func(userId string) error {
+			_, err := goals.DeleteGoal(ctx, &pb.DeleteGoalReq{Id: ids["goals"], UserId: userId})
+			return err
+		},
+		"ContributeToGoal": func(userId string) error {
+			_, err := goals.ContributeToGoal(ctx, &pb.ContributeToGoalReq{GoalId: ids["goals"], UserId: userId, Amount: 1})
+			return err
+		},
+		"GetGoalContributions": func(userId string) error {
+			_, err := goals.GetGoalContributions(ctx, &pb.GetGoalContributionsReq{GoalId: ids["goals"], UserId: userId, Page: 1, Limit: 10})
+			return err
+		},
+		"GetNotification": func(userId string) error {
+			_, err := notifications.GetNotification(ctx, &pb.GetNotificationReq{Id: ids["notifications"], UserId: userId})
+			return err
+		},
+		"UpdateNotification": func(userId string) error {
+			_, err := notifications.UpdateNotification(ctx, &pb.UpdateNotificationReq{Id: ids["notifications"], UserId: userId, IsRead: true})
+			return err
+		},
+		"DeleteNotification": func(userId string) error {
+			_, err := notifications.DeleteNotification(ctx, &pb.DeleteNotificationReq{Id: ids["notifications"], UserId: userId})
+			return err
+		},
+		"GetRecurringTransaction": func(userId string) error {
+			_, err := recurring.GetRecurringTransaction(ctx, &pb.GetRecurringTransactionReq{Id: ids["recurring_transactions"], UserId: userId})
+			return err
+		},
+		"DeleteRecurringTransaction": func(userId string) error {
+			_, err := recurring.DeleteRecurringTransaction(ctx, &pb.DeleteRecurringTransactionReq{Id: ids["recurring_transactions"], UserId: userId})
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		assert.Equal(t, codes.PermissionDenied, status.Code(call("other_user")), name)
+		assert.Equal(t, codes.PermissionDenied, status.Code(call("")), name)
+	}
+
+	// Boshqa foydalanuvchi hech narsani o'zgartirmagan bo'lishi kerak
+	account, err := accounts.GetAccount(ctx, &pb.GetAccountReq{Id: ids["accounts"], UserId: "owner_user"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(1000), account.Balance)
+
+	_, err = accounts.GetAccount(ctx, &pb.GetAccountReq{Id: uuid.NewString(), UserId: "owner_user"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = goals.GetGoal(ctx, &pb.GetGoalReq{Id: uuid.NewString(), UserId: "owner_user"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,112 @@
+package mongodb
+
+import (
+	"budgeting-service/pkg/errs"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// pageCursor sahifaning oxirgi elementi: saralash maydoni qiymati va _id, hamda
+// token berilgan saralash maydoni va tartibi. Sana maydonlari UnixMilli
+// ko'rinishida saqlanadi. Mijozga base64 ko'rinishida beriladi, ichki
+// tuzilishi ochiq emas.
+type pageCursor struct {
+	Value int64  `json:"v"`
+	Id    string `json:"id"`
+	Field string `json:"f"`
+	Asc   bool   `json:"asc,omitempty"`
+}
+
+func encodePageToken(cursor pageCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(token string) (*pageCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errs.InvalidField("page_token", "invalid page token")
+	}
+	var cursor pageCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.Id == "" {
+		return nil, errs.InvalidField("page_token", "invalid page token")
+	}
+	return &cursor, nil
+}
+
+// listPage ro'yxatni (field, _id) bo'yicha saralab sahifalaydi (standart - kamayish tartibida).
+// page berilsa eski skip/limit rejimi, aks holda page_token bo'yicha cursor rejimi ishlaydi.
+type listPage struct {
+	field   string
+	numeric bool // field sana emas, int64 qiymat
+	asc     bool
+	page    int64
+	limit   int64
+	token   string
+}
+
+func (p listPage) cursorMode() bool {
+	return p.page == 0
+}
+
+// nextToken value va id li elementdan keyingi sahifa tokeni.
+func (p listPage) nextToken(value int64, id string) string {
+	return encodePageToken(pageCursor{Value: value, Id: id, Field: p.field, Asc: p.asc})
+}
+
+// stages filtrdan keyin qo'shiladigan saralash va sahifalash bosqichlari.
+// Cursor rejimida keyingi sahifa borligini bilish uchun bitta ortiqcha element olinadi.
+func (p listPage) stages() (mongo.Pipeline, error) {
+	var pipeline mongo.Pipeline
+	if p.cursorMode() && p.token != "" {
+		cursor, err := decodePageToken(p.token)
+		if err != nil {
+			return nil, err
+		}
+		// Boshqa saralashdagi token qiymati bu maydon qiymati sifatida o'qilib qolardi
+		if cursor.Field != p.field || cursor.Asc != p.asc {
+			return nil, errs.InvalidField("page_token", "page token does not match the sort order")
+		}
+		var after interface{} = cursor.Value
+		if !p.numeric {
+			after = time.UnixMilli(cursor.Value)
+		}
+		op := "$lt"
+		if p.asc {
+			op = "$gt"
+		}
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: p.field, Value: bson.D{{Key: op, Value: after}}}},
+			bson.D{{Key: p.field, Value: after}, {Key: "_id", Value: bson.D{{Key: op, Value: cursor.Id}}}},
+		}}}}})
+	}
+	direction := -1
+	if p.asc {
+		direction = 1
+	}
+	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{{Key: p.field, Value: direction}, {Key: "_id", Value: direction}}}})
+
+	switch {
+	case p.limit <= 0:
+	case p.cursorMode():
+		pipeline = append(pipeline, bson.D{{Key: "$limit", Value: p.limit + 1}})
+	default:
+		pipeline = append(pipeline,
+			bson.D{{Key: "$skip", Value: (p.page - 1) * p.limit}},
+			bson.D{{Key: "$limit", Value: p.limit}},
+		)
+	}
+	return pipeline, nil
+}
+
+// trim olingan n ta elementdan nechtasi qaytarilishini va keyingi sahifa bor-yo'qligini aytadi.
+func (p listPage) trim(n int) (int, bool) {
+	if p.cursorMode() && p.limit > 0 && int64(n) > p.limit {
+		return int(p.limit), true
+	}
+	return n, false
+}
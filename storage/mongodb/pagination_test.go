@@ -0,0 +1,86 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/pkg/errs"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestPageToken(t *testing.T) {
+	date := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+	cursor, err := decodePageToken(listPage{field: "date", asc: true}.nextToken(date.UnixMilli(), "tx-1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "tx-1", cursor.Id)
+	assert.True(t, date.Equal(time.UnixMilli(cursor.Value)))
+	assert.Equal(t, "date", cursor.Field)
+	assert.True(t, cursor.Asc)
+
+	for _, token := range []string{"!!!", "bm90LWpzb24", "e30"} {
+		_, err := decodePageToken(token)
+		assert.Equal(t, errs.KindInvalidArgument, errs.KindOf(err), token)
+	}
+}
+
+func TestListPageStages(t *testing.T) {
+	// Eski rejim: skip/limit
+	page := listPage{field: "date", page: 3, limit: 10}
+	stages, err := page.stages()
+	assert.NoError(t, err)
+	assert.Len(t, stages, 3)
+	assert.Equal(t, int64(20), stages[1][0].Value)
+	n, more := page.trim(10)
+	assert.Equal(t, 10, n)
+	assert.False(t, more)
+
+	// Cursor rejimi: bitta ortiqcha element olinadi
+	page = listPage{field: "date", limit: 10}
+	page.token = page.nextToken(time.Now().UnixMilli(), "tx-1")
+	stages, err = page.stages()
+	assert.NoError(t, err)
+	assert.Len(t, stages, 3)
+	assert.Equal(t, "$match", stages[0][0].Key)
+	assert.Equal(t, bson.D{{Key: "date", Value: -1}, {Key: "_id", Value: -1}}, stages[1][0].Value)
+	assert.Equal(t, int64(11), stages[2][0].Value)
+	n, more = page.trim(11)
+	assert.Equal(t, 10, n)
+	assert.True(t, more)
+
+	// limit berilmasa barcha elementlar
+	stages, err = listPage{field: "created_at"}.stages()
+	assert.NoError(t, err)
+	assert.Len(t, stages, 1)
+
+	_, err = listPage{field: "date", token: "???"}.stages()
+	assert.Error(t, err)
+}
+
+func TestListPageTokenSortMismatch(t *testing.T) {
+	token := transactionListPage(&pb.GetTransactionsListReq{Limit: 5}).nextToken(time.Now().UnixMilli(), "tx-1")
+
+	for _, request := range []*pb.GetTransactionsListReq{
+		{SortBy: "amount", Limit: 5, PageToken: token},
+		{SortOrder: "asc", Limit: 5, PageToken: token},
+	} {
+		_, err := transactionListPage(request).stages()
+		assert.Equal(t, errs.KindInvalidArgument, errs.KindOf(err))
+	}
+
+	_, err := transactionListPage(&pb.GetTransactionsListReq{Limit: 5, PageToken: token}).stages()
+	assert.NoError(t, err)
+}
+
+func TestListPageAmountAscending(t *testing.T) {
+	page := transactionListPage(&pb.GetTransactionsListReq{SortBy: "AMOUNT", SortOrder: "asc", Limit: 5})
+	page.token = page.nextToken(1500, "tx-9")
+	stages, err := page.stages()
+	assert.NoError(t, err)
+	assert.Equal(t, bson.D{{Key: "amount", Value: 1}, {Key: "_id", Value: 1}}, stages[1][0].Value)
+
+	// Raqamli maydon uchun cursor qiymati sanaga aylantirilmaydi
+	after := stages[0][0].Value.(bson.D)[0].Value.(bson.A)[0].(bson.D)
+	assert.Equal(t, bson.D{{Key: "amount", Value: bson.D{{Key: "$gt", Value: int64(1500)}}}}, after)
+}
@@ -0,0 +1,497 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
+	"budgeting-service/pkg/recurrence"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Takrorlanuvchi tranzaksiya holatlari
+const (
+	RecurringStatusActive    = "active"
+	RecurringStatusPaused    = "paused"
+	RecurringStatusCompleted = "completed"
+)
+
+// O'tkazib yuboriladigan sanalar shu formatda saqlanadi
+const recurringDayLayout = "2006-01-02"
+
+type RecurringTransactionRepository interface {
+	CreateRecurringTransaction(ctx context.Context, request *pb.CreateRecurringTransactionReq) (*pb.CreateRecurringTransactionResp, error)
+	GetRecurringTransaction(ctx context.Context, request *pb.GetRecurringTransactionReq) (*pb.GetRecurringTransactionResp, error)
+	GetRecurringTransactionsList(ctx context.Context, request *pb.GetRecurringTransactionsListReq) (*pb.GetRecurringTransactionsListResp, error)
+	UpdateRecurringTransaction(ctx context.Context, request *pb.UpdateRecurringTransactionReq) (*pb.UpdateRecurringTransactionResp, error)
+	DeleteRecurringTransaction(ctx context.Context, request *pb.DeleteRecurringTransactionReq) (*pb.DeleteRecurringTransactionResp, error)
+	SkipRecurringOccurrence(ctx context.Context, request *pb.SkipRecurringOccurrenceReq) (*pb.SkipRecurringOccurrenceResp, error)
+	SetRecurringTransactionStatus(ctx context.Context, request *pb.SetRecurringTransactionStatusReq) (*pb.SetRecurringTransactionStatusResp, error)
+	// Scheduler uchun
+	GetDueRecurringTransactions(ctx context.Context, now time.Time) ([]models.RecurringTransaction, error)
+	AdvanceRecurringTransaction(ctx context.Context, id string, nextRun time.Time, completed bool) error
+	PauseRecurringTransaction(ctx context.Context, id string) error
+}
+
+type recurringTransactionRepositoryImpl struct {
+	coll *mongo.Collection
+}
+
+func NewRecurringTransactionRepository(db *mongo.Database) RecurringTransactionRepository {
+	return &recurringTransactionRepositoryImpl{coll: db.Collection("recurring_transactions")}
+}
+
+func (repo *recurringTransactionRepositoryImpl) CreateRecurringTransaction(ctx context.Context, request *pb.CreateRecurringTransactionReq) (*pb.CreateRecurringTransactionResp, error) {
+	if isTransfer(request.Type) {
+		return nil, errs.InvalidField("type", "transfers can not be recurring")
+	}
+	if _, err := signedAmount(request.Type, request.Amount); err != nil {
+		return nil, err
+	}
+	startDate, err := parseDate("start_date", "2006-01-02 15:04:05", request.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	var endDate *time.Time
+	if request.EndDate != "" {
+		date, err := parseDate("end_date", "2006-01-02 15:04:05", request.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		endDate = &date
+	}
+
+	rule := models.RecurringTransaction{
+		ID:        uuid.NewString(),
+		Frequency: strings.ToUpper(request.Frequency),
+		Interval:  int(request.Interval),
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+	schedule := RecurringSchedule(rule)
+	if err := schedule.Validate(); err != nil {
+		return nil, errs.InvalidField("schedule", err.Error())
+	}
+	nextRun, ok := schedule.First(startDate)
+	if !ok {
+		return nil, errs.InvalidField("schedule", "recurring transaction has no occurrences")
+	}
+	if err := repo.checkReferences(ctx, request.UserId, request.AccountId, request.CategoryId); err != nil {
+		return &pb.CreateRecurringTransactionResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	_, err = repo.coll.InsertOne(ctx, bson.D{
+		{Key: "_id", Value: rule.ID},
+		{Key: "user_id", Value: request.UserId},
+		{Key: "account_id", Value: request.AccountId},
+		{Key: "category_id", Value: request.CategoryId},
+		{Key: "type", Value: request.Type},
+		{Key: "amount", Value: request.Amount},
+		{Key: "description", Value: request.Description},
+		{Key: "frequency", Value: rule.Frequency},
+		{Key: "interval", Value: schedule.Interval},
+		{Key: "start_date", Value: startDate},
+		{Key: "end_date", Value: endDate},
+		{Key: "next_run", Value: nextRun},
+		{Key: "status", Value: RecurringStatusActive},
+		{Key: "skipped_dates", Value: []string{}},
+		{Key: "created_at", Value: time.Now()},
+		{Key: "updated_at", Value: time.Now()},
+		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		return &pb.CreateRecurringTransactionResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.CreateRecurringTransactionResp{
+		Status:  "success",
+		Message: "recurring transaction created successfully",
+		Id:      rule.ID,
+	}, nil
+}
+
+func (repo *recurringTransactionRepositoryImpl) GetRecurringTransaction(ctx context.Context, request *pb.GetRecurringTransactionReq) (*pb.GetRecurringTransactionResp, error) {
+	rule, err := repo.findRule(ctx, request.Id, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetRecurringTransactionResp{
+		RecurringTransaction: recurringToProto(rule),
+	}, nil
+}
+
+func (repo *recurringTransactionRepositoryImpl) GetRecurringTransactionsList(ctx context.Context, request *pb.GetRecurringTransactionsListReq) (*pb.GetRecurringTransactionsListResp, error) {
+	filter := bson.D{
+		{Key: "user_id", Value: request.UserId},
+		{Key: "deleted_at", Value: nil},
+	}
+	if request.Status != "" {
+		filter = append(filter, bson.E{Key: "status", Value: request.Status})
+	}
+
+	totalCount, err := repo.coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: filter}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "next_run", Value: 1}}}},
+		bson.D{{Key: "$skip", Value: (request.Page - 1) * request.Limit}},
+		bson.D{{Key: "$limit", Value: request.Limit}},
+	}
+	cursor, err := repo.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []models.RecurringTransaction
+	if err := cursor.All(ctx, &rules); err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, errs.NotFound("recurring transactions not found")
+	}
+
+	var results []*pb.RecurringTransaction
+	for _, rule := range rules {
+		results = append(results, recurringToProto(&rule))
+	}
+
+	return &pb.GetRecurringTransactionsListResp{
+		RecurringTransactions: results,
+		TotalCount:            totalCount,
+		Page:                  request.Page,
+		Limit:                 request.Limit,
+	}, nil
+}
+
+// UpdateRecurringTransaction qoidani yangilaydi. Jadval o'zgarsa, keyingi sana
+// avvalgi next_run dan boshlab qayta hisoblanadi, shuning uchun yaratilgan
+// tranzaksiyalar takrorlanmaydi.
+func (repo *recurringTransactionRepositoryImpl) UpdateRecurringTransaction(ctx context.Context, request *pb.UpdateRecurringTransactionReq) (*pb.UpdateRecurringTransactionResp, error) {
+	rule, err := repo.findRule(ctx, request.Id, request.UserId)
+	if err != nil {
+		return &pb.UpdateRecurringTransactionResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	if request.CategoryId != "" {
+		if err := repo.checkReferences(ctx, rule.UserId, rule.AccountId, request.CategoryId); err != nil {
+			return &pb.UpdateRecurringTransactionResp{
+				Status:  "error",
+				Message: err.Error(),
+			}, err
+		}
+		rule.CategoryId = request.CategoryId
+	}
+	if request.Amount != 0 {
+		if _, err := signedAmount(rule.Type, request.Amount); err != nil {
+			return nil, err
+		}
+		rule.Amount = request.Amount
+	}
+	if request.Description != "" {
+		rule.Description = request.Description
+	}
+	if request.Frequency != "" {
+		rule.Frequency = strings.ToUpper(request.Frequency)
+	}
+	if request.Interval != 0 {
+		rule.Interval = int(request.Interval)
+	}
+	if request.EndDate != "" {
+		endDate, err := parseDate("end_date", "2006-01-02 15:04:05", request.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		rule.EndDate = &endDate
+	}
+
+	schedule := RecurringSchedule(*rule)
+	if err := schedule.Validate(); err != nil {
+		return nil, errs.InvalidField("schedule", err.Error())
+	}
+	status := rule.Status
+	nextRun, ok := schedule.First(rule.NextRun)
+	if !ok {
+		status = RecurringStatusCompleted
+		nextRun = rule.NextRun
+	} else if status == RecurringStatusCompleted {
+		status = RecurringStatusActive
+	}
+
+	_, err = repo.coll.UpdateOne(ctx, bson.D{{Key: "_id", Value: rule.ID}}, bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "category_id", Value: rule.CategoryId},
+			{Key: "amount", Value: rule.Amount},
+			{Key: "description", Value: rule.Description},
+			{Key: "frequency", Value: rule.Frequency},
+			{Key: "interval", Value: rule.Interval},
+			{Key: "end_date", Value: rule.EndDate},
+			{Key: "next_run", Value: nextRun},
+			{Key: "status", Value: status},
+			{Key: "updated_at", Value: time.Now()},
+		}},
+	})
+	if err != nil {
+		return &pb.UpdateRecurringTransactionResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.UpdateRecurringTransactionResp{
+		Status:  "success",
+		Message: "recurring transaction updated successfully",
+	}, nil
+}
+
+func (repo *recurringTransactionRepositoryImpl) DeleteRecurringTransaction(ctx context.Context, request *pb.DeleteRecurringTransactionReq) (*pb.DeleteRecurringTransactionResp, error) {
+	if _, err := repo.findRule(ctx, request.Id, request.UserId); err != nil {
+		return &pb.DeleteRecurringTransactionResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	_, err := repo.coll.UpdateOne(ctx, ownedFilter(request.Id, request.UserId), bson.D{
+		{Key: "$set", Value: bson.D{{Key: "deleted_at", Value: time.Now()}}},
+	})
+	if err != nil {
+		return &pb.DeleteRecurringTransactionResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.DeleteRecurringTransactionResp{
+		Status:  "success",
+		Message: "recurring transaction deleted successfully",
+	}, nil
+}
+
+// SkipRecurringOccurrence jadvaldagi bitta sanani o'tkazib yuboradi: scheduler
+// shu kun uchun tranzaksiya yaratmaydi, keyingi sanalar esa odatdagidek davom etadi.
+func (repo *recurringTransactionRepositoryImpl) SkipRecurringOccurrence(ctx context.Context, request *pb.SkipRecurringOccurrenceReq) (*pb.SkipRecurringOccurrenceResp, error) {
+	date, err := parseDate("date", recurringDayLayout, request.Date)
+	if err != nil {
+		return nil, err
+	}
+	rule, err := repo.findRule(ctx, request.Id, request.UserId)
+	if err != nil {
+		return &pb.SkipRecurringOccurrenceResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	occurrence, ok := RecurringSchedule(*rule).First(date)
+	if !ok || occurrence.Format(recurringDayLayout) != request.Date {
+		return nil, errs.InvalidField("date", request.Date+" is not an occurrence of this recurring transaction")
+	}
+	if occurrence.Before(rule.NextRun) {
+		return nil, errs.FailedPrecondition("occurrence " + request.Date + " has already been processed")
+	}
+
+	_, err = repo.coll.UpdateOne(ctx, bson.D{{Key: "_id", Value: rule.ID}}, bson.D{
+		{Key: "$addToSet", Value: bson.D{{Key: "skipped_dates", Value: request.Date}}},
+		{Key: "$set", Value: bson.D{{Key: "updated_at", Value: time.Now()}}},
+	})
+	if err != nil {
+		return &pb.SkipRecurringOccurrenceResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.SkipRecurringOccurrenceResp{
+		Status:  "success",
+		Message: "occurrence skipped successfully",
+	}, nil
+}
+
+// SetRecurringTransactionStatus qoidani to'xtatadi yoki davom ettiradi.
+// To'xtatilgan davrdagi sanalar davom ettirilganda yaratilmaydi.
+func (repo *recurringTransactionRepositoryImpl) SetRecurringTransactionStatus(ctx context.Context, request *pb.SetRecurringTransactionStatusReq) (*pb.SetRecurringTransactionStatusResp, error) {
+	if request.Status != RecurringStatusActive && request.Status != RecurringStatusPaused {
+		return nil, errs.InvalidField("status", "invalid status: "+request.Status)
+	}
+	rule, err := repo.findRule(ctx, request.Id, request.UserId)
+	if err != nil {
+		return &pb.SetRecurringTransactionStatusResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	if rule.Status == RecurringStatusCompleted {
+		return nil, errs.FailedPrecondition("recurring transaction is already completed")
+	}
+
+	set := bson.D{
+		{Key: "status", Value: request.Status},
+		{Key: "updated_at", Value: time.Now()},
+	}
+	if request.Status == RecurringStatusActive && rule.Status == RecurringStatusPaused {
+		from := rule.NextRun
+		if now := time.Now(); now.After(from) {
+			from = now
+		}
+		nextRun, ok := RecurringSchedule(*rule).First(from)
+		if ok {
+			set = append(set, bson.E{Key: "next_run", Value: nextRun})
+		} else {
+			set[0].Value = RecurringStatusCompleted
+		}
+	}
+
+	_, err = repo.coll.UpdateOne(ctx, bson.D{{Key: "_id", Value: rule.ID}}, bson.D{{Key: "$set", Value: set}})
+	if err != nil {
+		return &pb.SetRecurringTransactionStatusResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	return &pb.SetRecurringTransactionStatusResp{
+		Status:  "success",
+		Message: "recurring transaction status updated successfully",
+	}, nil
+}
+
+// GetDueRecurringTransactions navbatdagi sanasi now dan oshmagan faol qoidalarni qaytaradi.
+func (repo *recurringTransactionRepositoryImpl) GetDueRecurringTransactions(ctx context.Context, now time.Time) ([]models.RecurringTransaction, error) {
+	cursor, err := repo.coll.Find(ctx, bson.D{
+		{Key: "status", Value: RecurringStatusActive},
+		{Key: "next_run", Value: bson.D{{Key: "$lte", Value: now}}},
+		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []models.RecurringTransaction
+	if err := cursor.All(ctx, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// AdvanceRecurringTransaction qoidaning navbatdagi sanasini yangilaydi.
+// completed bo'lsa, jadval tugagan va qoida boshqa ishga tushmaydi.
+func (repo *recurringTransactionRepositoryImpl) AdvanceRecurringTransaction(ctx context.Context, id string, nextRun time.Time, completed bool) error {
+	set := bson.D{
+		{Key: "last_run_at", Value: time.Now()},
+		{Key: "updated_at", Value: time.Now()},
+	}
+	if completed {
+		set = append(set, bson.E{Key: "status", Value: RecurringStatusCompleted})
+	} else {
+		set = append(set, bson.E{Key: "next_run", Value: nextRun})
+	}
+
+	_, err := repo.coll.UpdateOne(ctx, bson.D{{Key: "_id", Value: id}}, bson.D{{Key: "$set", Value: set}})
+	return err
+}
+
+// PauseRecurringTransaction qoidani to'xtatadi (masalan, hisobi o'chirilgan bo'lsa).
+// Foydalanuvchi uni SetRecurringTransactionStatus bilan qayta yoqishi mumkin.
+func (repo *recurringTransactionRepositoryImpl) PauseRecurringTransaction(ctx context.Context, id string) error {
+	_, err := repo.coll.UpdateOne(ctx, bson.D{
+		{Key: "_id", Value: id},
+		{Key: "status", Value: RecurringStatusActive},
+	}, bson.D{{Key: "$set", Value: bson.D{
+		{Key: "status", Value: RecurringStatusPaused},
+		{Key: "updated_at", Value: time.Now()},
+	}}})
+	return err
+}
+
+func (repo *recurringTransactionRepositoryImpl) findRule(ctx context.Context, id, userId string) (*models.RecurringTransaction, error) {
+	var rule models.RecurringTransaction
+	if err := findOwned(ctx, repo.coll, id, userId, ErrRecurringTransactionNotFound, &rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// checkReferences qoida hisobida foydalanuvchi kamida editor ekanini va kategoriya
+// unga tegishli ekanini tekshiradi.
+func (repo *recurringTransactionRepositoryImpl) checkReferences(ctx context.Context, userId, accountId, categoryId string) error {
+	db := repo.coll.Database()
+	if _, err := checkAccountRole(ctx, db, accountId, userId, AccountRoleEditor); err != nil {
+		return err
+	}
+	if categoryId == "" {
+		return nil
+	}
+	return findOwned(ctx, db.Collection("categories"), categoryId, userId, ErrCategoryNotFound, nil)
+}
+
+// RecurringSchedule qoidaning jadvalini qaytaradi.
+func RecurringSchedule(rule models.RecurringTransaction) recurrence.Schedule {
+	schedule := recurrence.Schedule{
+		Frequency: rule.Frequency,
+		Interval:  rule.Interval,
+		Start:     rule.StartDate,
+	}
+	if schedule.Interval == 0 {
+		schedule.Interval = 1
+	}
+	if rule.EndDate != nil {
+		schedule.End = *rule.EndDate
+	}
+	return schedule
+}
+
+// OccurrenceTransactionId qoida va sana bo'yicha doim bir xil tranzaksiya id sini
+// qaytaradi. Scheduler qayta ishga tushsa ham, bitta sana uchun ikkinchi
+// tranzaksiya yaratilmaydi (ErrTransactionExists).
+func OccurrenceTransactionId(ruleId string, occurrence time.Time) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(ruleId+"/"+occurrence.UTC().Format(time.RFC3339))).String()
+}
+
+// IsSkipped sana foydalanuvchi tomonidan o'tkazib yuborilganmi.
+func IsSkipped(rule models.RecurringTransaction, occurrence time.Time) bool {
+	day := occurrence.Format(recurringDayLayout)
+	for _, skipped := range rule.SkippedDates {
+		if skipped == day {
+			return true
+		}
+	}
+	return false
+}
+
+func recurringToProto(rule *models.RecurringTransaction) *pb.RecurringTransaction {
+	result := &pb.RecurringTransaction{
+		Id:           rule.ID,
+		UserId:       rule.UserId,
+		AccountId:    rule.AccountId,
+		CategoryId:   rule.CategoryId,
+		Type:         rule.Type,
+		Amount:       rule.Amount,
+		Description:  rule.Description,
+		Frequency:    rule.Frequency,
+		Interval:     int32(rule.Interval),
+		StartDate:    rule.StartDate.Format("2006-01-02 15:04:05"),
+		NextRun:      rule.NextRun.Format("2006-01-02 15:04:05"),
+		Status:       rule.Status,
+		SkippedDates: rule.SkippedDates,
+	}
+	if rule.EndDate != nil {
+		result.EndDate = rule.EndDate.Format("2006-01-02 15:04:05")
+	}
+	return result
+}
@@ -0,0 +1,58 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/models"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateRecurringTransaction(t *testing.T) {
+	db, err := ConnectToMongoDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Client().Disconnect(context.Background())
+
+	repo := NewRecurringTransactionRepository(db)
+	resp, err := repo.CreateRecurringTransaction(context.Background(), &pb.CreateRecurringTransactionReq{
+		UserId:      "test_user_id",
+		AccountId:   "test_account_id",
+		CategoryId:  "test_category_id",
+		Type:        TransactionTypeExpense,
+		Amount:      50000,
+		Description: "Rent",
+		Frequency:   "MONTHLY",
+		Interval:    1,
+		StartDate:   "2024-01-31 00:00:00",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "success", resp.Status)
+
+	rule, err := repo.GetRecurringTransaction(context.Background(), &pb.GetRecurringTransactionReq{Id: resp.Id, UserId: "test_user_id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "2024-01-31 00:00:00", rule.RecurringTransaction.NextRun)
+	assert.Equal(t, RecurringStatusActive, rule.RecurringTransaction.Status)
+}
+
+func TestOccurrenceTransactionId(t *testing.T) {
+	date := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, OccurrenceTransactionId("rule", date), OccurrenceTransactionId("rule", date))
+	assert.NotEqual(t, OccurrenceTransactionId("rule", date), OccurrenceTransactionId("rule", date.AddDate(0, 1, 0)))
+	assert.NotEqual(t, OccurrenceTransactionId("rule", date), OccurrenceTransactionId("other", date))
+}
+
+func TestIsSkipped(t *testing.T) {
+	rule := models.RecurringTransaction{SkippedDates: []string{"2024-03-31"}}
+
+	assert.True(t, IsSkipped(rule, time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC)))
+	assert.False(t, IsSkipped(rule, time.Date(2024, 4, 30, 9, 0, 0, 0, time.UTC)))
+}
@@ -3,7 +3,11 @@ package mongodb
 import (
 	pb "budgeting-service/generated/budgeting"
 	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
+	"budgeting-service/pkg/exchange"
 	"context"
+	"slices"
+	"sort"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -13,354 +17,560 @@ import (
 type ReportingRepository interface {
 	GetSependingReport(ctx context.Context, request *pb.GetSependingReq) (*pb.GetSependingResp, error)
 	GetIncomeReport(ctx context.Context, request *pb.GetIncomeReportReq) (*pb.GetIncomeReportResp, error)
+	GetSpendingByTag(ctx context.Context, request *pb.GetSpendingByTagReq) (*pb.GetSpendingByTagResp, error)
+	GetSpendingByCategory(ctx context.Context, request *pb.GetSpendingByCategoryReq) (*pb.GetSpendingByCategoryResp, error)
 	GetBudgetPerformance(ctx context.Context, request *pb.GetBudgetPerformanceReq) (*pb.GetBudgetPerformanceResp, error)
 	GetGoalsProgress(ctx context.Context, request *pb.GetGoalProgressReq) (*pb.GetGoalProgressResp, error)
+	GetBudgetActual(ctx context.Context, budget models.GetBudget) (int64, error)
+	ForecastGoal(ctx context.Context, request *pb.ForecastGoalReq) (*pb.ForecastGoalResp, error)
+	GetGoalsInProgress(ctx context.Context) ([]models.GetGoal, error)
+	CheckGoalAtRisk(ctx context.Context, goal models.GetGoal, now time.Time) error
 }
 
+// GetBudgetPerformance da history_periods berilmasa, shuncha oldingi davr ko'rsatiladi
+const defaultHistoryPeriods = 6
+
 type reportingRepositoryImpl struct {
-	db *mongo.Database
+	db           *mongo.Database
+	rates        ExchangeRateRepository
+	userSettings UserSettingsRepository
+	budgets      BudgetManagementRepo
 }
 
 func NewReportingRepository(db *mongo.Database) ReportingRepository {
-	return &reportingRepositoryImpl{db: db}
+	return &reportingRepositoryImpl{
+		db:           db,
+		rates:        NewExchangeRateRepository(db),
+		userSettings: NewUserSettingsRepository(db),
+		budgets:      NewBudgetManagementRepo(db),
+	}
 }
 
 func (repo *reportingRepositoryImpl) GetSependingReport(ctx context.Context, request *pb.GetSependingReq) (*pb.GetSependingResp, error) {
-	pipeline := mongo.Pipeline{}
+	total, baseCurrency, err := repo.periodTotal(ctx, request.UserId, TransactionTypeExpense, request.GetYearly(), request.GetMonthly())
+	if err != nil {
+		return nil, err
+	}
 
-	// Boshlang'ich match
-	pipeline = append(pipeline, bson.D{
-		{Key: "$match", Value: bson.D{
-			{Key: "user_id", Value: request.UserId},
-			{Key: "type", Value: "expense"},
-		}},
-	})
+	return &pb.GetSependingResp{
+		TotalAmount:  total,
+		Yearly:       request.GetYearly(),
+		Monthly:      request.GetMonthly(),
+		BaseCurrency: baseCurrency,
+	}, nil
+}
 
-	// Vaqt oralig'i uchun match
-	if request.GetYearly() {
-		// Oxirgi 1 yil uchun vaqt oralig'i
-		startOfYear := time.Now().AddDate(-1, 0, 0)
-		pipeline = append(pipeline, bson.D{
-			{Key: "$match", Value: bson.D{
-				{Key: "date", Value: bson.D{
-					{Key: "$gte", Value: startOfYear},
-				}},
-			}},
-		})
-	} else if request.GetMonthly() {
-		// Oxirgi 1 oy uchun vaqt oralig'i
-		startOfMonth := time.Now().AddDate(0, -1, 0)
-		pipeline = append(pipeline, bson.D{
-			{Key: "$match", Value: bson.D{
-				{Key: "date", Value: bson.D{
-					{Key: "$gte", Value: startOfMonth},
-				}},
-			}},
-		})
+func (repo *reportingRepositoryImpl) GetIncomeReport(ctx context.Context, request *pb.GetIncomeReportReq) (*pb.GetIncomeReportResp, error) {
+	total, baseCurrency, err := repo.periodTotal(ctx, request.UserId, TransactionTypeIncome, request.GetYearly(), request.GetMonthly())
+	if err != nil {
+		return nil, err
 	}
 
-	// Vaqt bo'yicha agregatsiya
-	if request.GetYearly() {
-		pipeline = append(pipeline, bson.D{
-			{Key: "$group", Value: bson.D{
-				{Key: "_id", Value: nil},
-				{Key: "total_amount", Value: bson.D{{Key: "$sum", Value: "$amount"}}},
-			}},
-		})
-	} else if request.GetMonthly() {
-		pipeline = append(pipeline, bson.D{
-			{Key: "$group", Value: bson.D{
-				{Key: "_id", Value: bson.D{
-					{Key: "$dateToString", Value: bson.D{
-						{Key: "format", Value: "%Y-%m"}, // Yil va oy
-						{Key: "date", Value: "$date"},
-					}},
-				}},
-				{Key: "total_amount", Value: bson.D{{Key: "$sum", Value: "$amount"}}},
-			}},
-		})
-	} else {
-		// Agar vaqt intervali ko'rsatilmagan bo'lsa
-		pipeline = append(pipeline, bson.D{
-			{Key: "$group", Value: bson.D{
-				{Key: "_id", Value: nil},
-				{Key: "total_amount", Value: bson.D{{Key: "$sum", Value: "$amount"}}},
-			}},
-		})
-	}
+	return &pb.GetIncomeReportResp{
+		TotalAmount:  total,
+		Yearly:       request.GetYearly(),
+		Monthly:      request.GetMonthly(),
+		BaseCurrency: baseCurrency,
+	}, nil
+}
 
-	// Aggregatsiya
-	cursor, err := repo.db.Collection("transactions").Aggregate(ctx, pipeline)
+// GetSpendingByTag teglangan xarajatlarni har bir teg bo'yicha asosiy valyutada yig'adi.
+// Bir nechta tegi bor tranzaksiya har bir tegning yig'indisiga qo'shiladi.
+func (repo *reportingRepositoryImpl) GetSpendingByTag(ctx context.Context, request *pb.GetSpendingByTagReq) (*pb.GetSpendingByTagResp, error) {
+	accountIds, err := accessibleAccountIds(ctx, repo.db, request.UserId)
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(ctx) // Ensure cursor is closed after usage
+	tags := normalizeTags(request.Tags)
+	tagFilter := bson.D{{Key: "$exists", Value: true}, {Key: "$ne", Value: bson.A{}}}
+	if len(tags) > 0 {
+		tagFilter = bson.D{{Key: "$in", Value: tags}}
+	}
+	match := bson.D{
+		{Key: "account_id", Value: bson.D{{Key: "$in", Value: accountIds}}},
+		{Key: "type", Value: TransactionTypeExpense},
+		{Key: "tags", Value: tagFilter},
+		{Key: "deleted_at", Value: nil},
+	}
 
-	var result pb.GetSependingResp
-	if cursor.Next(ctx) {
-		if err := cursor.Decode(&result); err != nil {
+	date := bson.D{}
+	if request.StartDate != "" {
+		start, err := parseDate("start_date", "2006-01-02 15:04:05", request.StartDate)
+		if err != nil {
 			return nil, err
 		}
-	} else {
-		if err := cursor.Err(); err != nil {
+		date = append(date, bson.E{Key: "$gte", Value: start})
+	}
+	if request.EndDate != "" {
+		end, err := parseDate("end_date", "2006-01-02 15:04:05", request.EndDate)
+		if err != nil {
 			return nil, err
 		}
-		return nil, mongo.ErrNoDocuments
+		date = append(date, bson.E{Key: "$lte", Value: end})
+	}
+	if len(date) > 0 {
+		match = append(match, bson.E{Key: "date", Value: date})
 	}
 
-	// Moslashtirilgan javob
-	result.Yearly = request.GetYearly()
-	result.Monthly = request.GetMonthly()
+	transactions, err := repo.reportTransactions(ctx, match)
+	if err != nil {
+		return nil, err
+	}
+	if len(transactions) == 0 {
+		return nil, errs.NotFound("transactions not found")
+	}
 
-	return &result, nil
+	baseCurrency, table, err := repo.converter(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	spending, err := tagTotals(transactions, tags, table, baseCurrency)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetSpendingByTagResp{
+		Tags:         spending,
+		BaseCurrency: baseCurrency,
+	}, nil
 }
 
-func (repo *reportingRepositoryImpl) GetIncomeReport(ctx context.Context, request *pb.GetIncomeReportReq) (*pb.GetIncomeReportResp, error) {
-	pipeline := mongo.Pipeline{}
+// tagTotals tranzaksiyalarni teglar bo'yicha yig'adi (eng ko'p xarajat birinchi).
+// only berilsa, faqat shu teglar hisoblanadi.
+func tagTotals(transactions []models.ReportTransaction, only []string, table *exchange.Table, baseCurrency string) ([]*pb.TagSpending, error) {
+	totals := map[string]*pb.TagSpending{}
+	for _, transaction := range transactions {
+		converted, err := table.Convert(transaction.Amount, transaction.Currency, baseCurrency, transaction.Date)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range transaction.Tags {
+			if len(only) > 0 && !slices.Contains(only, tag) {
+				continue
+			}
+			total, ok := totals[tag]
+			if !ok {
+				total = &pb.TagSpending{Tag: tag}
+				totals[tag] = total
+			}
+			total.Amount += converted
+			total.TransactionsCount++
+		}
+	}
 
-	// Boshlang'ich match
-	pipeline = append(pipeline, bson.D{
-		{Key: "$match", Value: bson.D{
-			{Key: "user_id", Value: request.UserId},
-			{Key: "type", Value: "monthly"},
-		}},
+	result := make([]*pb.TagSpending, 0, len(totals))
+	for _, total := range totals {
+		result = append(result, total)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Amount != result[j].Amount {
+			return result[i].Amount > result[j].Amount
+		}
+		return result[i].Tag < result[j].Tag
 	})
+	return result, nil
+}
 
-	// Vaqt oralig'i uchun match
-	if request.GetYearly() {
-		// Oxirgi 1 yil uchun vaqt oralig'i
-		startOfYear := time.Now().AddDate(-1, 0, 0)
-		pipeline = append(pipeline, bson.D{
-			{Key: "$match", Value: bson.D{
-				{Key: "date", Value: bson.D{
-					{Key: "$gte", Value: startOfYear},
-				}},
-			}},
-		})
-	} else if request.GetMonthly() {
-		// Oxirgi 1 oy uchun vaqt oralig'i
-		startOfMonth := time.Now().AddDate(0, -1, 0)
-		pipeline = append(pipeline, bson.D{
-			{Key: "$match", Value: bson.D{
-				{Key: "date", Value: bson.D{
-					{Key: "$gte", Value: startOfMonth},
-				}},
-			}},
-		})
+// GetSpendingByCategory foydalanuvchiga ochiq hisoblardagi xarajatlarni kategoriyalar
+// bo'yicha asosiy valyutada yig'adi. amount - kategoriyaning o'z xarajati, total -
+// ichki kategoriyalari bilan birga. Bo'lingan tranzaksiya qismlari o'z kategoriyasiga tushadi.
+func (repo *reportingRepositoryImpl) GetSpendingByCategory(ctx context.Context, request *pb.GetSpendingByCategoryReq) (*pb.GetSpendingByCategoryResp, error) {
+	accountIds, err := accessibleAccountIds(ctx, repo.db, request.UserId)
+	if err != nil {
+		return nil, err
 	}
-
-	// Vaqt bo'yicha agregatsiya
-	if request.GetYearly() {
-		pipeline = append(pipeline, bson.D{
-			{Key: "$group", Value: bson.D{
-				{Key: "_id", Value: nil},
-				{Key: "total_amount", Value: bson.D{{Key: "$sum", Value: "$amount"}}},
-			}},
-		})
-	} else if request.GetMonthly() {
-		pipeline = append(pipeline, bson.D{
-			{Key: "$group", Value: bson.D{
-				{Key: "_id", Value: bson.D{
-					{Key: "$dateToString", Value: bson.D{
-						{Key: "format", Value: "%Y-%m"}, // Yil va oy
-						{Key: "date", Value: "$date"},
-					}},
-				}},
-				{Key: "total_amount", Value: bson.D{{Key: "$sum", Value: "$amount"}}},
-			}},
-		})
-	} else {
-		// Agar vaqt intervali ko'rsatilmagan bo'lsa
-		pipeline = append(pipeline, bson.D{
-			{Key: "$group", Value: bson.D{
-				{Key: "_id", Value: nil},
-				{Key: "total_amount", Value: bson.D{{Key: "$sum", Value: "$amount"}}},
-			}},
-		})
+	match := bson.D{
+		{Key: "account_id", Value: bson.D{{Key: "$in", Value: accountIds}}},
+		{Key: "type", Value: TransactionTypeExpense},
+		{Key: "deleted_at", Value: nil},
+	}
+	date := bson.D{}
+	if request.StartDate != "" {
+		start, err := parseDate("start_date", "2006-01-02 15:04:05", request.StartDate)
+		if err != nil {
+			return nil, err
+		}
+		date = append(date, bson.E{Key: "$gte", Value: start})
+	}
+	if request.EndDate != "" {
+		end, err := parseDate("end_date", "2006-01-02 15:04:05", request.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		date = append(date, bson.E{Key: "$lte", Value: end})
+	}
+	if len(date) > 0 {
+		match = append(match, bson.E{Key: "date", Value: date})
 	}
 
-	// Aggregatsiya
-	cursor, err := repo.db.Collection("transactions").Aggregate(ctx, pipeline)
+	transactions, err := repo.categoryTransactions(ctx, nil, match)
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(ctx) // Ensure cursor is closed after usage
+	if len(transactions) == 0 {
+		return nil, errs.NotFound("transactions not found")
+	}
 
-	var result models.IncomeReport
-	var answer pb.GetIncomeReportResp
-	if cursor.Next(ctx) {
-		if err := cursor.Decode(&result); err != nil {
-			return nil, err
-		}
-		answer = pb.GetIncomeReportResp{
-			TotalAmount: result.TotalAmount,
-			Yearly:     result.Yearly,
-            Monthly: result.Monthly,
+	baseCurrency, table, err := repo.converter(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	// Umumiy hisoblarda boshqa a'zolarning kategoriyalari ham uchraydi
+	var categoryIds []string
+	seen := map[string]bool{}
+	for _, transaction := range transactions {
+		if transaction.CategoryId != "" && !seen[transaction.CategoryId] {
+			seen[transaction.CategoryId] = true
+			categoryIds = append(categoryIds, transaction.CategoryId)
 		}
-	} else {
-		if err := cursor.Err(); err != nil {
+	}
+	tree, err := loadCategoryTreeOf(ctx, repo.db, categoryIds)
+	if err != nil {
+		return nil, err
+	}
+	spending, err := categoryTotals(transactions, tree, table, baseCurrency)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetSpendingByCategoryResp{
+		Categories:   spending,
+		BaseCurrency: baseCurrency,
+	}, nil
+}
+
+// categoryTotals kategoriya qatorlarini yig'adi va ichki kategoriyalar xarajatini
+// ota kategoriyalarga qo'shadi (eng katta total birinchi). Kategoriyasiz xarajat
+// category_id bo'sh qatorga tushadi.
+func categoryTotals(transactions []models.ReportTransaction, tree *categoryTree, table *exchange.Table, baseCurrency string) ([]*pb.CategorySpending, error) {
+	amounts := map[string]int64{}
+	counts := map[string]int64{}
+	for _, transaction := range transactions {
+		converted, err := table.Convert(transaction.Amount, transaction.Currency, baseCurrency, transaction.Date)
+		if err != nil {
 			return nil, err
 		}
-		return nil, mongo.ErrNoDocuments
+		amounts[transaction.CategoryId] += converted
+		counts[transaction.CategoryId]++
 	}
 
-	// Moslashtirilgan javob
-	result.Yearly = request.GetYearly()
-	result.Monthly = request.GetMonthly()
-
-	return &answer, nil
+	result := []*pb.CategorySpending{}
+	for categoryId, total := range tree.rollup(amounts) {
+		category := tree.nodes[categoryId]
+		result = append(result, &pb.CategorySpending{
+			CategoryId:        categoryId,
+			Name:              category.Name,
+			ParentId:          category.ParentId,
+			Amount:            amounts[categoryId],
+			Total:             total,
+			TransactionsCount: counts[categoryId],
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Total != result[j].Total {
+			return result[i].Total > result[j].Total
+		}
+		return result[i].CategoryId < result[j].CategoryId
+	})
+	return result, nil
 }
 
 func (repo *reportingRepositoryImpl) GetBudgetPerformance(ctx context.Context, request *pb.GetBudgetPerformanceReq) (*pb.GetBudgetPerformanceResp, error) {
-	pipeline := mongo.Pipeline{
-		bson.D{{
-			Key: "$match", Value: bson.D{
-				{Key: "user_id", Value: request.UserId},
-				{Key: "period", Value: "MONTHLY"},
-				{Key: "start_date", Value: bson.D{
-					{Key: "$gte", Value: time.Date(int(request.Year), time.Month(request.Month), 1, 0, 0, 0, 0, time.UTC)},
-					{Key: "$lt", Value: time.Date(int(request.Year), time.Month(request.Month)+1, 1, 0, 0, 0, 0, time.UTC)},
-				}},
-			},
-		}},
-		bson.D{{
-			Key: "$lookup", Value: bson.D{
-				{Key: "from", Value: "transactions"},
-				{Key: "localField", Value: "category_id"},
-				{Key: "foreignField", Value: "category_id"},
-				{Key: "as", Value: "transactions"},
-			},
-		}},
-		bson.D{{
-			Key: "$unwind", Value: "$transactions",
-		}},
-		bson.D{{
-			Key: "$group", Value: bson.D{
-				{Key: "_id", Value: "$category_id"},
-				{Key: "target", Value: bson.D{{Key: "$first", Value: "$amount"}}},
-				{Key: "actual", Value: bson.D{{Key: "$sum", Value: "$transactions.amount"}}},
-			},
+	accountIds, err := accessibleAccountIds(ctx, repo.db, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	// Shaxsiy byudjetlar va foydalanuvchiga ochiq hisoblar byudjetlari
+	filter := bson.D{
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "user_id", Value: request.UserId}, budgetAccountFilter("")},
+			bson.D{{Key: "account_id", Value: bson.D{{Key: "$in", Value: accountIds}}}},
 		}},
-		bson.D{{
-			Key: "$project", Value: bson.D{
-				{Key: "_id", Value: 0},
-				{Key: "category_id", Value: "$_id"},
-				{Key: "target", Value: 1},
-				{Key: "actual", Value: 1},
-				{Key: "progress", Value: bson.D{{Key: "$subtract", Value: bson.A{"$target", "$actual"}}}},
-			},
+		{Key: "period", Value: "MONTHLY"},
+		{Key: "start_date", Value: bson.D{
+			{Key: "$gte", Value: time.Date(int(request.Year), time.Month(request.Month), 1, 0, 0, 0, 0, time.UTC)},
+			{Key: "$lt", Value: time.Date(int(request.Year), time.Month(request.Month)+1, 1, 0, 0, 0, 0, time.UTC)},
 		}},
+		{Key: "deleted_at", Value: nil},
 	}
 
-	cursor, err := repo.db.Collection("budgets").Aggregate(ctx, pipeline)
+	cursor, err := repo.db.Collection("budgets").Find(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
+	var budgets []models.GetBudget
+	if err := cursor.All(ctx, &budgets); err != nil {
+		return nil, err
+	}
+	if len(budgets) == 0 {
+		return nil, errs.NotFound("budgets not found")
+	}
+
+	baseCurrency, table, err := repo.converter(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	historyPeriods := int64(request.HistoryPeriods)
+	if historyPeriods <= 0 {
+		historyPeriods = defaultHistoryPeriods
+	}
+
 	var results []*pb.BudgetPerformance
-	for cursor.Next(ctx) {
-		var result models.BudgetPerformance
-		if err := cursor.Decode(&result); err != nil {
+	for _, budget := range budgets {
+		actual, err := repo.budgetActual(ctx, budget, baseCurrency, table)
+		if err != nil {
+			return nil, err
+		}
+		history, err := repo.budgets.GetBudgetHistory(ctx, budget, historyPeriods)
+		if err != nil {
 			return nil, err
 		}
+
+		var periods []*pb.BudgetPeriod
+		for _, period := range history {
+			periods = append(periods, &pb.BudgetPeriod{
+				BudgetId:       period.BudgetId,
+				StartDate:      period.StartDate.Format("2006-01-02 15:04:05"),
+				EndDate:        period.EndDate.Format("2006-01-02 15:04:05"),
+				Target:         period.Target,
+				Actual:         period.Actual,
+				CarriedForward: period.CarriedForward,
+			})
+		}
+
 		results = append(results, &pb.BudgetPerformance{
-			CategoryId: result.CategoryId,
-			Target:     result.Target,
-			Actual:     result.Actual,
-			Progress:   result.Progress,
+			CategoryId:    budget.CategoryId,
+			Target:        budget.Amount,
+			Actual:        actual,
+			Progress:      budget.Amount - actual,
+			BudgetId:      budget.ID,
+			CarriedAmount: budget.CarriedAmount,
+			History:       periods,
+			Trend:         spendingTrend(actual, history),
 		})
 	}
-	if err := cursor.Err(); err != nil {
-		return nil, err
-	}
-	if len(results) == 0 {
-		return nil, mongo.ErrNoDocuments
-	}
 
 	return &pb.GetBudgetPerformanceResp{
 		UserId:                request.UserId,
 		Year:                  request.Year,
 		Month:                 request.Month,
 		BudgetPerformanceList: results,
+		BaseCurrency:          baseCurrency,
 	}, nil
 }
 
 func (repo *reportingRepositoryImpl) GetGoalsProgress(ctx context.Context, request *pb.GetGoalProgressReq) (*pb.GetGoalProgressResp, error) {
-	pipeline := mongo.Pipeline{
-		bson.D{{
-			Key: "$match", Value: bson.D{
-				{Key: "user_id", Value: request.UserId},
-				{Key: "status", Value: "in_progress"},
-			},
+	cursor, err := repo.db.Collection("goals").Find(ctx, bson.D{
+		{Key: "user_id", Value: request.UserId},
+		{Key: "status", Value: bson.D{{Key: "$in", Value: bson.A{GoalStatusInProgress, GoalStatusCompleted}}}},
+		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var goals []models.GetGoal
+	if err := cursor.All(ctx, &goals); err != nil {
+		return nil, err
+	}
+	if len(goals) == 0 {
+		return nil, errs.NotFound("goals not found")
+	}
+
+	baseCurrency, err := repo.userSettings.GetBaseCurrency(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	netIncome, err := repo.netIncome(ctx, request.UserId, now.AddDate(0, -forecastLookbackMonths, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	// current_amount maqsadga qilingan jamg'armalar yig'indisi (goal_contributions)
+	var results []*pb.GoalProgress
+	for _, goal := range goals {
+		var progress float64
+		if goal.TargetAmount != 0 {
+			progress = float64(goal.CurrentAmount) / float64(goal.TargetAmount) * 100
+		}
+		forecast, err := repo.forecast(ctx, goal, netIncome, now)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &pb.GoalProgress{
+			Id:                          goal.ID,
+			Name:                        goal.Name,
+			TargetAmount:                goal.TargetAmount,
+			CurrentAmount:               goal.CurrentAmount,
+			Progress:                    progress,
+			AtRisk:                      forecast.AtRisk,
+			ProjectedCompletionDate:     formatProjection(forecast.ProjectedCompletion),
+			RequiredMonthlyContribution: forecast.RequiredMonthly,
+		})
+	}
+
+	return &pb.GetGoalProgressResp{
+		GoalProgress: results,
+		BaseCurrency: baseCurrency,
+	}, nil
+}
+
+// GetBudgetActual byudjet davridagi kategoriya xarajatlarini asosiy valyutada qaytaradi.
+func (repo *reportingRepositoryImpl) GetBudgetActual(ctx context.Context, budget models.GetBudget) (int64, error) {
+	baseCurrency, table, err := repo.converter(ctx, budget.UserId)
+	if err != nil {
+		return 0, err
+	}
+	return repo.budgetActual(ctx, budget, baseCurrency, table)
+}
+
+func (repo *reportingRepositoryImpl) budgetActual(ctx context.Context, budget models.GetBudget, baseCurrency string, table *exchange.Table) (int64, error) {
+	// Byudjet davridagi shu kategoriya va uning ichki kategoriyalari xarajatlari.
+	// Hisobga bog'langan byudjetda hisobning barcha a'zolari xarajati hisoblanadi.
+	// Bo'lingan tranzaksiyadan faqat shu kategoriyalarga tegishli qismlar olinadi.
+	tree, err := loadCategoryTree(ctx, repo.db, budget.UserId)
+	if err != nil {
+		return 0, err
+	}
+	categoryIds := tree.subtree(budget.CategoryId)
+	owner := bson.E{Key: "user_id", Value: budget.UserId}
+	if budget.AccountId != "" {
+		owner = bson.E{Key: "account_id", Value: budget.AccountId}
+	}
+	transactions, err := repo.categoryTransactions(ctx, categoryIds, bson.D{
+		owner,
+		categoryFilter(categoryIds),
+		{Key: "type", Value: TransactionTypeExpense},
+		{Key: "date", Value: bson.D{
+			{Key: "$gte", Value: budget.StartDate},
+			{Key: "$lte", Value: budget.EndDate},
 		}},
+		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return convertTotal(transactions, table, baseCurrency)
+}
+
+// spendingTrend joriy xarajatning oldingi davrga nisbatan o'zgarishi (foizda).
+func spendingTrend(actual int64, history []models.BudgetHistory) float64 {
+	if len(history) == 0 || history[0].Actual == 0 {
+		return 0
+	}
+	previous := history[0].Actual
+	return float64(actual-previous) / float64(previous) * 100
+}
+
+// periodTotal berilgan turdagi tranzaksiyalar yig'indisini asosiy valyutada hisoblaydi.
+// yearly - oxirgi 1 yil, monthly - oxirgi 1 oy, aks holda butun tarix.
+func (repo *reportingRepositoryImpl) periodTotal(ctx context.Context, userId, transactionType string, yearly, monthly bool) (int64, string, error) {
+	// O'z hisoblari va unga ulashilgan hisoblardagi tranzaksiyalar
+	accountIds, err := accessibleAccountIds(ctx, repo.db, userId)
+	if err != nil {
+		return 0, "", err
+	}
+	match := bson.D{
+		{Key: "account_id", Value: bson.D{{Key: "$in", Value: accountIds}}},
+		{Key: "type", Value: transactionType},
+		{Key: "deleted_at", Value: nil},
+	}
+	if yearly {
+		match = append(match, bson.E{Key: "date", Value: bson.D{{Key: "$gte", Value: time.Now().AddDate(-1, 0, 0)}}})
+	} else if monthly {
+		match = append(match, bson.E{Key: "date", Value: bson.D{{Key: "$gte", Value: time.Now().AddDate(0, -1, 0)}}})
+	}
+
+	transactions, err := repo.reportTransactions(ctx, match)
+	if err != nil {
+		return 0, "", err
+	}
+	if len(transactions) == 0 {
+		return 0, "", errs.NotFound("transactions not found")
+	}
+
+	baseCurrency, table, err := repo.converter(ctx, userId)
+	if err != nil {
+		return 0, "", err
+	}
+	total, err := convertTotal(transactions, table, baseCurrency)
+	if err != nil {
+		return 0, "", err
+	}
+	return total, baseCurrency, nil
+}
+
+// reportTransactions tranzaksiyalarni hisob valyutasi bilan birga qaytaradi.
+func (repo *reportingRepositoryImpl) reportTransactions(ctx context.Context, match bson.D) ([]models.ReportTransaction, error) {
+	return repo.aggregateReport(ctx, mongo.Pipeline{bson.D{{Key: "$match", Value: match}}})
+}
+
+// categoryTransactions reportTransactions kabi, lekin har bir tranzaksiya kategoriya
+// qatorlariga ajratiladi va faqat categoryIds ga tushgan qatorlar olinadi
+// (bo'lingan tranzaksiyalarda o'sha qismlar). categoryIds bo'sh bo'lsa barcha qatorlar.
+func (repo *reportingRepositoryImpl) categoryTransactions(ctx context.Context, categoryIds []string, match bson.D) ([]models.ReportTransaction, error) {
+	pipeline := mongo.Pipeline{bson.D{{Key: "$match", Value: match}}}
+	return repo.aggregateReport(ctx, append(pipeline, splitLines(categoryIds)...))
+}
+
+func (repo *reportingRepositoryImpl) aggregateReport(ctx context.Context, pipeline mongo.Pipeline) ([]models.ReportTransaction, error) {
+	pipeline = append(pipeline,
 		bson.D{{
 			Key: "$lookup", Value: bson.D{
 				{Key: "from", Value: "accounts"},
-				{Key: "localField", Value: "user_id"},
-				{Key: "foreignField", Value: "user_id"},
-				{Key: "as", Value: "account_details"},
-			},
-		}},
-		bson.D{{
-			Key: "$unwind", Value: bson.D{
-				{Key: "path", Value: "$account_details"},
-				{Key: "preserveNullAndEmptyArrays", Value: true},
-			},
-		}},
-		bson.D{{
-			Key: "$lookup", Value: bson.D{
-				{Key: "from", Value: "transactions"},
-				{Key: "localField", Value: "account_details._id"},
-				{Key: "foreignField", Value: "account_id"},
-				{Key: "as", Value: "related_transactions"},
+				{Key: "localField", Value: "account_id"},
+				{Key: "foreignField", Value: "_id"},
+				{Key: "as", Value: "account"},
 			},
 		}},
+		bson.D{{Key: "$unwind", Value: "$account"}},
 		bson.D{{
-			Key: "$unwind", Value: bson.D{
-				{Key: "path", Value: "$related_transactions"},
-				{Key: "preserveNullAndEmptyArrays", Value: true},
-			},
-		}},
-		bson.D{{
-			Key: "$group", Value: bson.D{
-				{Key: "_id", Value: "$_id"},
-				{Key: "name", Value: bson.D{{Key: "$first", Value: "$name"}}},
-				{Key: "target_amount", Value: bson.D{{Key: "$first", Value: "$target_amount"}}},
-				{Key: "current_amount", Value: bson.D{{Key: "$sum", Value: "$related_transactions.amount"}}},
-			},
-		}},
-		bson.D{{
-			Key: "$addFields", Value: bson.D{
-				{Key: "progress", Value: bson.D{{Key: "$multiply", Value: bson.A{
-					bson.D{{Key: "$divide", Value: bson.A{"$current_amount", "$target_amount"}}}, 100,
-				}}}},
+			Key: "$project", Value: bson.D{
+				{Key: "account_id", Value: 1},
+				{Key: "category_id", Value: 1},
+				{Key: "type", Value: 1},
+				{Key: "amount", Value: 1},
+				{Key: "date", Value: 1},
+				{Key: "tags", Value: 1},
+				{Key: "currency", Value: "$account.currency"},
 			},
 		}},
-	}
+	)
 
-	cursor, err := repo.db.Collection("goals").Aggregate(ctx, pipeline)
+	cursor, err := repo.db.Collection("transactions").Aggregate(ctx, pipeline)
 	if err != nil {
 		return nil, err
 	}
 
-	var results []*pb.GoalProgress
-	for cursor.Next(ctx) {
-		var result models.GoalProgress
-		if err := cursor.Decode(&result); err != nil {
-			return nil, err
-		}
-		results = append(results, &pb.GoalProgress{
-			Id:            result.Id,
-            Name:         result.Name,
-            TargetAmount: result.TargetAmount,
-            CurrentAmount: result.CurrentAmount,
-            Progress:     result.Progress,
-		})
+	var transactions []models.ReportTransaction
+	if err := cursor.All(ctx, &transactions); err != nil {
+		return nil, err
 	}
-	if len(results) == 0 {
-		return nil, mongo.ErrNoDocuments
+	return transactions, nil
+}
+
+func (repo *reportingRepositoryImpl) converter(ctx context.Context, userId string) (string, *exchange.Table, error) {
+	baseCurrency, err := repo.userSettings.GetBaseCurrency(ctx, userId)
+	if err != nil {
+		return "", nil, err
+	}
+	table, err := repo.rates.GetRatesTable(ctx)
+	if err != nil {
+		return "", nil, err
 	}
+	return baseCurrency, table, nil
+}
 
-	return &pb.GetGoalProgressResp{
-		GoalProgress: results,
-	}, nil
+// convertTotal har bir tranzaksiyani o'z sanasidagi kurs bo'yicha asosiy valyutaga
+// o'tkazib yig'adi.
+func convertTotal(transactions []models.ReportTransaction, table *exchange.Table, baseCurrency string) (int64, error) {
+	var total int64
+	for _, transaction := range transactions {
+		converted, err := table.Convert(transaction.Amount, transaction.Currency, baseCurrency, transaction.Date)
+		if err != nil {
+			return 0, err
+		}
+		total += converted
+	}
+	return total, nil
 }
@@ -0,0 +1,374 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var ErrSuspectedDuplicateNotFound = errs.NotFound("suspected duplicate not found")
+
+// Ehtimoliy dublikat holatlari
+const (
+	DuplicateStatusOpen      = "open"
+	DuplicateStatusMerged    = "merged"
+	DuplicateStatusDismissed = "dismissed"
+)
+
+const (
+	// DuplicateWindowDays shu necha kun ichidagi tranzaksiyalar solishtiriladi
+	DuplicateWindowDays = 3
+	// MinDuplicateScore dan past o'xshashlik dublikat hisoblanmaydi
+	MinDuplicateScore = 0.5
+	// MinDescriptionSimilarity dan kam o'xshash tavsiflar sanasi qanchalik
+	// yaqin bo'lmasin, dublikat hisoblanmaydi
+	MinDescriptionSimilarity = 0.5
+)
+
+// FlagDuplicates tranzaksiyani shu hisobdagi bir xil summa va turdagi, sanasi
+// yaqin tranzaksiyalar bilan solishtiradi va eng o'xshashi yetarlicha o'xshash
+// bo'lsa, ehtimoliy dublikat sifatida belgilaydi. Topilgan tranzaksiya id si
+// qaytadi, topilmasa bo'sh. Bir juftlik qayta belgilanmaydi, shuning uchun rad
+// etilgan (dismissed) belgi qaytib ochilmaydi.
+func (repo *transactionRepositoryImpl) FlagDuplicates(ctx context.Context, transactionId string) (string, error) {
+	var transaction models.GetTransaction
+	err := repo.coll.FindOne(ctx, bson.D{{Key: "_id", Value: transactionId}, {Key: "deleted_at", Value: nil}}).Decode(&transaction)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return "", ErrTransactionNotFound
+	} else if err != nil {
+		return "", err
+	}
+	if transaction.TransferId != "" {
+		return "", nil
+	}
+
+	window := DuplicateWindowDays * 24 * time.Hour
+	cursor, err := repo.coll.Find(ctx, bson.D{
+		{Key: "account_id", Value: transaction.AccountId},
+		{Key: "type", Value: transaction.Type},
+		{Key: "amount", Value: transaction.Amount},
+		{Key: "date", Value: bson.D{
+			{Key: "$gte", Value: transaction.Date.Add(-window)},
+			{Key: "$lte", Value: transaction.Date.Add(window)},
+		}},
+		{Key: "_id", Value: bson.D{{Key: "$ne", Value: transaction.Id}}},
+		{Key: "transfer_id", Value: bson.D{{Key: "$exists", Value: false}}},
+		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		return "", err
+	}
+	var candidates []models.GetTransaction
+	if err := cursor.All(ctx, &candidates); err != nil {
+		return "", err
+	}
+
+	var best *models.GetTransaction
+	bestScore := 0.0
+	for i := range candidates {
+		if score := duplicateScore(transaction, candidates[i]); score > bestScore {
+			best, bestScore = &candidates[i], score
+		}
+	}
+	if best == nil || bestScore < MinDuplicateScore {
+		return "", nil
+	}
+
+	_, err = repo.db.Collection("suspected_duplicates").UpdateOne(ctx,
+		bson.D{{Key: "_id", Value: duplicatePairId(transaction.Id, best.Id)}},
+		bson.D{{Key: "$setOnInsert", Value: bson.D{
+			{Key: "account_id", Value: transaction.AccountId},
+			{Key: "transaction_id", Value: transaction.Id},
+			{Key: "duplicate_of", Value: best.Id},
+			{Key: "score", Value: bestScore},
+			{Key: "status", Value: DuplicateStatusOpen},
+			{Key: "created_at", Value: time.Now()},
+		}}},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return "", err
+	}
+	return best.Id, nil
+}
+
+// suspectedDuplicateRow ro'yxat uchun ikkala tranzaksiya bilan birga.
+type suspectedDuplicateRow struct {
+	models.SuspectedDuplicate `bson:",inline"`
+	Transaction               models.GetTransaction `bson:"transaction"`
+	Original                  models.GetTransaction `bson:"original"`
+}
+
+// ListSuspectedDuplicates ko'rib chiqilmagan ehtimoliy dublikatlar, eng yangilari birinchi.
+// Tranzaksiyalardan biri o'chirilgan bo'lsa, juftlik ko'rsatilmaydi.
+func (repo *transactionRepositoryImpl) ListSuspectedDuplicates(ctx context.Context, request *pb.ListSuspectedDuplicatesReq) (*pb.ListSuspectedDuplicatesResp, error) {
+	var accountIds bson.A
+	if request.AccountId != "" {
+		if _, err := checkAccountRole(ctx, repo.db, request.AccountId, request.UserId, AccountRoleViewer); err != nil {
+			return nil, err
+		}
+		accountIds = bson.A{request.AccountId}
+	} else {
+		ids, err := accessibleAccountIds(ctx, repo.db, request.UserId)
+		if err != nil {
+			return nil, err
+		}
+		accountIds = ids
+	}
+
+	page := listPage{field: "created_at", limit: request.Limit, token: request.PageToken}
+	stages, err := page.stages()
+	if err != nil {
+		return nil, err
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "account_id", Value: bson.D{{Key: "$in", Value: accountIds}}},
+			{Key: "status", Value: DuplicateStatusOpen},
+		}}},
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: "transactions"},
+			{Key: "localField", Value: "transaction_id"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "transaction"},
+		}}},
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: "transactions"},
+			{Key: "localField", Value: "duplicate_of"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "original"},
+		}}},
+		{{Key: "$unwind", Value: "$transaction"}},
+		{{Key: "$unwind", Value: "$original"}},
+		{{Key: "$match", Value: bson.D{
+			{Key: "transaction.deleted_at", Value: nil},
+			{Key: "original.deleted_at", Value: nil},
+		}}},
+	}
+	pipeline = append(pipeline, stages...)
+
+	cursor, err := repo.db.Collection("suspected_duplicates").Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var items []suspectedDuplicateRow
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, err
+	}
+	n, more := page.trim(len(items))
+
+	resp := &pb.ListSuspectedDuplicatesResp{}
+	for _, item := range items[:n] {
+		resp.Duplicates = append(resp.Duplicates, &pb.SuspectedDuplicate{
+			Id:          item.ID,
+			AccountId:   item.AccountId,
+			Transaction: transactionToPb(item.Transaction),
+			DuplicateOf: transactionToPb(item.Original),
+			Score:       item.Score,
+			CreatedAt:   item.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	if more {
+		last := items[n-1]
+		resp.NextPageToken = page.nextToken(last.CreatedAt.UnixMilli(), last.ID)
+	}
+	return resp, nil
+}
+
+// MergeDuplicates ehtimoliy dublikatni hal qiladi. dismiss bo'lsa faqat belgi
+// yopiladi. Aks holda bitta tranzaksiya qoldiriladi: o'chiriladiganining teglari,
+// izohi va biriktirmalari unga ko'chiriladi, o'zi esa balans va journal bilan
+// birga o'chiriladi.
+func (repo *transactionRepositoryImpl) MergeDuplicates(ctx context.Context, request *pb.MergeDuplicatesReq) (*pb.MergeDuplicatesResp, error) {
+	resp := &pb.MergeDuplicatesResp{Status: "success"}
+	duplicates := repo.db.Collection("suspected_duplicates")
+
+	err := withTransaction(ctx, repo.db, func(sc mongo.SessionContext) error {
+		var flag models.SuspectedDuplicate
+		err := duplicates.FindOne(sc, bson.D{{Key: "_id", Value: request.Id}, {Key: "status", Value: DuplicateStatusOpen}}).Decode(&flag)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return ErrSuspectedDuplicateNotFound
+		} else if err != nil {
+			return err
+		}
+		if _, err := checkAccountRole(sc, repo.db, flag.AccountId, request.UserId, AccountRoleEditor); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if request.Dismiss {
+			resp.Message = "duplicate dismissed"
+			_, err := duplicates.UpdateOne(sc, bson.D{{Key: "_id", Value: flag.ID}}, bson.D{{Key: "$set", Value: bson.D{
+				{Key: "status", Value: DuplicateStatusDismissed},
+				{Key: "resolved_at", Value: now},
+			}}})
+			return err
+		}
+
+		keepId, removeId := flag.DuplicateOf, flag.TransactionId
+		switch request.KeepTransactionId {
+		case "", flag.DuplicateOf:
+		case flag.TransactionId:
+			keepId, removeId = removeId, keepId
+		default:
+			return errs.InvalidField("keep_transaction_id", "must be one of the suspected duplicates")
+		}
+		keep, err := repo.findTransaction(sc, keepId, request.UserId, AccountRoleEditor)
+		if err != nil {
+			return err
+		}
+		remove, err := repo.findTransaction(sc, removeId, request.UserId, AccountRoleEditor)
+		if err != nil {
+			return err
+		}
+
+		set := bson.D{
+			{Key: "notes", Value: mergeNotes(keep.Notes, remove.Notes)},
+			{Key: "updated_at", Value: now},
+		}
+		update := bson.D{{Key: "$set", Value: set}}
+		if len(remove.Tags) > 0 {
+			update = append(update, bson.E{Key: "$addToSet", Value: bson.D{{Key: "tags", Value: bson.D{{Key: "$each", Value: remove.Tags}}}}})
+		}
+		if len(remove.Attachments) > 0 {
+			update = append(update, bson.E{Key: "$push", Value: bson.D{{Key: "attachments", Value: bson.D{{Key: "$each", Value: remove.Attachments}}}}})
+		}
+		if _, err := repo.coll.UpdateOne(sc, bson.D{{Key: "_id", Value: keep.Id}}, update); err != nil {
+			return err
+		}
+		if err := repo.removeTransaction(sc, *remove); err != nil {
+			return err
+		}
+
+		// O'chirilgan tranzaksiyaga tegishli boshqa belgilar ham yopiladi
+		_, err = duplicates.UpdateMany(sc, bson.D{
+			{Key: "status", Value: DuplicateStatusOpen},
+			{Key: "$or", Value: bson.A{
+				bson.D{{Key: "transaction_id", Value: remove.Id}},
+				bson.D{{Key: "duplicate_of", Value: remove.Id}},
+			}},
+		}, bson.D{{Key: "$set", Value: bson.D{
+			{Key: "status", Value: DuplicateStatusMerged},
+			{Key: "resolved_at", Value: now},
+		}}})
+		if err != nil {
+			return err
+		}
+
+		resp.Message = "duplicates merged successfully"
+		resp.KeptTransactionId = keep.Id
+		resp.RemovedTransactionId = remove.Id
+		return nil
+	})
+	if err != nil {
+		return &pb.MergeDuplicatesResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	return resp, nil
+}
+
+// duplicatePairId ikki tranzaksiya juftligi uchun tartibga bog'liq bo'lmagan id.
+func duplicatePairId(a, b string) string {
+	if b < a {
+		a, b = b, a
+	}
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte("duplicate/"+a+"/"+b)).String()
+}
+
+// duplicateScore ikki tranzaksiyaning o'xshashligi (0..1): sanalar yaqinligi va
+// tavsiflar o'xshashligining o'rtachasi. Tavsiflar MinDescriptionSimilarity dan
+// kam o'xshash bo'lsa 0. Summa, tur va hisob oldindan tenglashtirilgan.
+func duplicateScore(a, b models.GetTransaction) float64 {
+	days := calendarDays(a.Date, b.Date)
+	if days > DuplicateWindowDays {
+		return 0
+	}
+	similarity := descriptionSimilarity(a.Description, b.Description)
+	if similarity < MinDescriptionSimilarity {
+		return 0
+	}
+	dateScore := 1 - float64(days)/float64(DuplicateWindowDays+1)
+	return (dateScore + similarity) / 2
+}
+
+func calendarDays(a, b time.Time) int {
+	dayA := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	dayB := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(dayA.Sub(dayB).Hours() / 24)
+	if days < 0 {
+		return -days
+	}
+	return days
+}
+
+// descriptionSimilarity tavsiflarni faqat harflari bo'yicha (raqamlar odatda karta
+// yoki to'lov raqami) harf juftliklarining Dice koeffitsienti bilan solishtiradi.
+func descriptionSimilarity(a, b string) float64 {
+	a, b = descriptionLetters(a), descriptionLetters(b)
+	switch {
+	case a == b:
+		return 1
+	case a == "" || b == "":
+		return 0
+	}
+
+	bigramsA, bigramsB := bigrams(a), bigrams(b)
+	if len(bigramsA) == 0 || len(bigramsB) == 0 {
+		return 0
+	}
+	counts := map[string]int{}
+	for _, bigram := range bigramsA {
+		counts[bigram]++
+	}
+	common := 0
+	for _, bigram := range bigramsB {
+		if counts[bigram] > 0 {
+			counts[bigram]--
+			common++
+		}
+	}
+	return 2 * float64(common) / float64(len(bigramsA)+len(bigramsB))
+}
+
+func descriptionLetters(value string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(value) {
+		if unicode.IsLetter(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func bigrams(value string) []string {
+	runes := []rune(value)
+	var result []string
+	for i := 0; i+1 < len(runes); i++ {
+		result = append(result, string(runes[i:i+2]))
+	}
+	return result
+}
+
+func mergeNotes(keep, remove string) string {
+	switch {
+	case remove == "" || remove == keep:
+		return keep
+	case keep == "":
+		return remove
+	}
+	return keep + "\n" + remove
+}
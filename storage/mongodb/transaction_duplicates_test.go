@@ -0,0 +1,41 @@
+package mongodb
+
+import (
+	"budgeting-service/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescriptionSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, descriptionSimilarity("", ""))
+	assert.Equal(t, 1.0, descriptionSimilarity("STARBUCKS #1234", "Starbucks 5678"))
+	assert.Equal(t, 0.0, descriptionSimilarity("Coffee", ""))
+	assert.Greater(t, descriptionSimilarity("Starbucks", "STARBUCKS COFFEE SEATTLE"), 0.5)
+	assert.Less(t, descriptionSimilarity("Starbucks", "Amazon Marketplace"), 0.2)
+}
+
+func TestDuplicateScore(t *testing.T) {
+	date := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	a := models.GetTransaction{Description: "Netflix", Date: date}
+
+	assert.Equal(t, 1.0, duplicateScore(a, models.GetTransaction{Description: "NETFLIX 0042", Date: date.Add(5 * time.Hour)}))
+	// Bir kunda bo'lsa ham tavsifi umuman boshqa tranzaksiya dublikat emas
+	assert.Equal(t, 0.0, duplicateScore(a, models.GetTransaction{Description: "Groceries", Date: date}))
+	assert.Equal(t, 0.0, duplicateScore(a, models.GetTransaction{Description: "Groceries", Date: date.AddDate(0, 0, 1)}))
+	assert.Greater(t, duplicateScore(a, models.GetTransaction{Description: "Netflix", Date: date.AddDate(0, 0, -2)}), MinDuplicateScore)
+	assert.Equal(t, 0.0, duplicateScore(a, models.GetTransaction{Description: "Netflix", Date: date.AddDate(0, 0, DuplicateWindowDays+1)}))
+}
+
+func TestDuplicatePairId(t *testing.T) {
+	assert.Equal(t, duplicatePairId("a", "b"), duplicatePairId("b", "a"))
+	assert.NotEqual(t, duplicatePairId("a", "b"), duplicatePairId("a", "c"))
+}
+
+func TestMergeNotes(t *testing.T) {
+	assert.Equal(t, "kept", mergeNotes("kept", ""))
+	assert.Equal(t, "kept", mergeNotes("kept", "kept"))
+	assert.Equal(t, "removed", mergeNotes("", "removed"))
+	assert.Equal(t, "kept\nremoved", mergeNotes("kept", "removed"))
+}
@@ -3,7 +3,12 @@ package mongodb
 import (
 	pb "budgeting-service/generated/budgeting"
 	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
+	"budgeting-service/pkg/money"
 	"context"
+	"errors"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,39 +16,118 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
+var ErrTransactionExists = errs.AlreadyExists("transaction already exists")
+
+// Tranzaksiyalar ro'yxatini saralash
+const (
+	TransactionSortDate   = "date"
+	TransactionSortAmount = "amount"
+
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
 type TransactionRepository interface {
 	CreateTransaction(ctx context.Context, transaction *pb.CreateTransactionReq) (*pb.CreateTransactionResp, error)
 	UpdateTransaction(ctx context.Context, transaction *pb.UpdateTransactionReq) (*pb.UpdateTransactionResp, error)
 	DeleteTransaction(ctx context.Context, request *pb.DeleteTransactionReq) (*pb.DeleteTransactionResp, error)
 	GetTransaction(ctx context.Context, request *pb.GetTransactionReq) (*pb.GetTransactionResp, error)
 	GetTransactionsList(ctx context.Context, request *pb.GetTransactionsListReq) (*pb.GetTransactionsListResp, error)
+	CreateTransfer(ctx context.Context, transfer *pb.CreateTransferReq) (*pb.CreateTransferResp, error)
+	GetTransferLegs(ctx context.Context, transferId string) ([]models.GetTransaction, error)
+	AddTransactionTags(ctx context.Context, request *pb.AddTransactionTagsReq) (*pb.AddTransactionTagsResp, error)
+	RemoveTransactionTags(ctx context.Context, request *pb.RemoveTransactionTagsReq) (*pb.RemoveTransactionTagsResp, error)
+	AddTransactionAttachment(ctx context.Context, request *pb.AddTransactionAttachmentReq) (*pb.AddTransactionAttachmentResp, error)
+	RemoveTransactionAttachment(ctx context.Context, request *pb.RemoveTransactionAttachmentReq) (*pb.RemoveTransactionAttachmentResp, error)
+	FlagDuplicates(ctx context.Context, transactionId string) (string, error)
+	ListSuspectedDuplicates(ctx context.Context, request *pb.ListSuspectedDuplicatesReq) (*pb.ListSuspectedDuplicatesResp, error)
+	MergeDuplicates(ctx context.Context, request *pb.MergeDuplicatesReq) (*pb.MergeDuplicatesResp, error)
+	CountCategoryTransactions(ctx context.Context, userId, categoryId string) (int64, error)
 }
 
 type transactionRepositoryImpl struct {
-	coll *mongo.Collection
+	db       *mongo.Database
+	coll     *mongo.Collection
+	accounts *mongo.Collection
+	journal  JournalRepository
 }
 
 func NewTransactionRepository(db *mongo.Database) TransactionRepository {
-	return &transactionRepositoryImpl{coll: db.Collection("transactions")}
+	return &transactionRepositoryImpl{
+		db:       db,
+		coll:     db.Collection("transactions"),
+		accounts: db.Collection("accounts"),
+		journal:  NewJournalRepository(db),
+	}
 }
 
 func (repo *transactionRepositoryImpl) CreateTransaction(ctx context.Context, transaction *pb.CreateTransactionReq) (*pb.CreateTransactionResp, error) {
-	date, err := time.Parse("2006-01-02 15:04:05", transaction.Date)
+	date, err := parseDate("date", "2006-01-02 15:04:05", transaction.Date)
 	if err != nil {
 		return nil, err
 	}
-	_, err = repo.coll.InsertOne(ctx, bson.D{
-		{Key: "_id", Value: uuid.NewString()},
-		{Key: "account_id", Value: transaction.AccountId},
-		{Key: "user_id", Value: transaction.UserId},
-		{Key: "type", Value: transaction.Type},
-		{Key: "amount", Value: transaction.Amount},
-		{Key: "description", Value: transaction.Description},
-		{Key: "date", Value: date},
-		{Key: "created_at", Value: time.Now()},
-		{Key: "updated_at", Value: time.Now()},
-		{Key: "deleted_at", Value: nil},
+	if isTransfer(transaction.Type) {
+		return nil, errs.InvalidField("type", "transfer transactions must be created with CreateTransfer")
+	}
+	delta, err := signedAmount(transaction.Type, transaction.Amount)
+	if err != nil {
+		return nil, err
+	}
+	splits, err := splitsFromPb(transaction.Splits, transaction.Amount, transaction.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	newTransaction := models.GetTransaction{
+		Id:          transaction.Id,
+		AccountId:   transaction.AccountId,
+		UserId:      transaction.UserId,
+		CategoryId:  transaction.CategoryId,
+		Type:        transaction.Type,
+		Amount:      transaction.Amount,
+		Description: transaction.Description,
+		Date:        date,
+		Tags:        normalizeTags(transaction.Tags),
+		Notes:       transaction.Notes,
+		Splits:      splits,
+
+		IdempotencyKey: transaction.IdempotencyKey,
+		Counterparty:   transaction.Counterparty,
+	}
+	// Id berilgan bo'lsa (masalan, takrorlanuvchi tranzaksiyadan), u qayta yaratilmaydi
+	if newTransaction.Id == "" {
+		newTransaction.Id = uuid.NewString()
+	}
+
+	err = withTransaction(ctx, repo.db, func(sc mongo.SessionContext) error {
+		if err := repo.checkReferences(sc, newTransaction); err != nil {
+			return err
+		}
+		// Kategoriya berilmagan bo'lsa, foydalanuvchi qoidalari qo'llanadi
+		if err := categorizeTransaction(sc, repo.db, &newTransaction); err != nil {
+			return err
+		}
+		return repo.insertTransaction(sc, newTransaction, delta)
 	})
+	if mongo.IsDuplicateKeyError(err) {
+		// Qayta yuborilgan so'rovga avval yaratilgan tranzaksiya id si qaytariladi.
+		// Id boshqa foydalanuvchi tranzaksiyasiniki bo'lsa, id qaytarilmaydi.
+		existingId, err := repo.idempotentTransactionId(ctx, newTransaction.UserId, newTransaction.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+		if existingId == "" {
+			existingId, err = repo.ownTransactionId(ctx, newTransaction.UserId, newTransaction.Id)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &pb.CreateTransactionResp{
+			Status:  "error",
+			Message: ErrTransactionExists.Error(),
+			Id:      existingId,
+		}, ErrTransactionExists
+	}
 
 	if err != nil {
 		return &pb.CreateTransactionResp{
@@ -52,45 +136,159 @@ func (repo *transactionRepositoryImpl) CreateTransaction(ctx context.Context, tr
 		}, err
 	}
 	return &pb.CreateTransactionResp{
-		Status:  "success",
-		Message: "created transaction successfully",
+		Status:     "success",
+		Message:    "created transaction successfully",
+		Id:         newTransaction.Id,
+		CategoryId: newTransaction.CategoryId,
 	}, nil
 }
 
+// idempotentTransactionId foydalanuvchining shu kalit bilan yaratilgan tranzaksiyasi id si.
+// Kalit berilmagan yoki topilmasa (to'qnashuv _id bo'yicha bo'lgan), bo'sh qaytadi.
+func (repo *transactionRepositoryImpl) idempotentTransactionId(ctx context.Context, userId, key string) (string, error) {
+	if key == "" {
+		return "", nil
+	}
+	var existing models.GetTransaction
+	err := repo.coll.FindOne(ctx, bson.D{
+		{Key: "user_id", Value: userId},
+		{Key: "idempotency_key", Value: key},
+	}).Decode(&existing)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return existing.Id, nil
+}
+
+// ownTransactionId id li tranzaksiya foydalanuvchiniki bo'lsa, uning id si, aks holda bo'sh.
+func (repo *transactionRepositoryImpl) ownTransactionId(ctx context.Context, userId, id string) (string, error) {
+	var existing models.GetTransaction
+	err := repo.coll.FindOne(ctx, bson.D{
+		{Key: "_id", Value: id},
+		{Key: "user_id", Value: userId},
+	}).Decode(&existing)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return existing.Id, nil
+}
+
 func (repo *transactionRepositoryImpl) UpdateTransaction(ctx context.Context, transaction *pb.UpdateTransactionReq) (*pb.UpdateTransactionResp, error) {
-	updateDate, err := time.Parse("2006-01-02 15:04:05", transaction.Date)
+	updateDate, err := parseDate("date", "2006-01-02 15:04:05", transaction.Date)
 	if err != nil {
 		return nil, err
 	}
-
-	update := bson.D{
-		{Key: "$set", Value: bson.D{
-			{Key: "type", Value: transaction.Type},
-			{Key: "amount", Value: transaction.Amount},
-			{Key: "description", Value: transaction.Description},
-			{Key: "date", Value: updateDate},
-			{Key: "updated_at", Value: time.Now()},
-		}},
+	if isTransfer(transaction.Type) {
+		return nil, errs.InvalidField("type", "transaction type can not be changed to transfer")
+	}
+	newDelta, err := signedAmount(transaction.Type, transaction.Amount)
+	if err != nil {
+		return nil, err
+	}
+	splits, err := splitsFromPb(transaction.Splits, transaction.Amount, transaction.CategoryId)
+	if err != nil {
+		return nil, err
 	}
 
 	filter := bson.D{
 		{Key: "_id", Value: transaction.Id},
 		{Key: "deleted_at", Value: nil},
 	}
+	set := bson.D{
+		{Key: "type", Value: transaction.Type},
+		{Key: "amount", Value: transaction.Amount},
+		{Key: "description", Value: transaction.Description},
+		{Key: "counterparty", Value: transaction.Counterparty},
+		{Key: "notes", Value: transaction.Notes},
+		{Key: "date", Value: updateDate},
+		{Key: "updated_at", Value: time.Now()},
+	}
+	unset := bson.D{}
+	// Bo'lingan tranzaksiyaning o'z kategoriyasi bo'lmaydi
+	if len(splits) > 0 {
+		set = append(set, bson.E{Key: "splits", Value: splits}, bson.E{Key: "category_id", Value: ""})
+	} else {
+		unset = append(unset, bson.E{Key: "splits", Value: ""})
+	}
+	// Qo'lda berilgan kategoriya qoida natijasini almashtiradi
+	if transaction.CategoryId != "" {
+		set = append(set, bson.E{Key: "category_id", Value: transaction.CategoryId})
+		unset = append(unset, bson.E{Key: "category_rule_id", Value: ""})
+	}
+	update := bson.D{{Key: "$set", Value: set}}
+	if len(unset) > 0 {
+		update = append(update, bson.E{Key: "$unset", Value: unset})
+	}
+
+	err = withTransaction(ctx, repo.db, func(sc mongo.SessionContext) error {
+		old, err := repo.findTransaction(sc, transaction.Id, transaction.UserId, AccountRoleEditor)
+		if err != nil {
+			return err
+		}
+		if old.TransferId != "" {
+			return errs.FailedPrecondition("transfer transactions can not be updated")
+		}
+		categoryIds := []string{transaction.CategoryId}
+		for _, split := range splits {
+			categoryIds = append(categoryIds, split.CategoryId)
+		}
+		for _, categoryId := range categoryIds {
+			if categoryId == "" {
+				continue
+			}
+			if err := findOwned(sc, repo.db.Collection("categories"), categoryId, transaction.UserId, ErrCategoryNotFound, nil); err != nil {
+				return err
+			}
+		}
+		oldDelta, err := signedAmount(old.Type, old.Amount)
+		if err != nil {
+			return err
+		}
+
+		if _, err := repo.coll.UpdateOne(sc, filter, update); err != nil {
+			return err
+		}
+		// Eski summani qaytarib, yangisini qo'llaymiz
+		account, err := adjustBalance(sc, repo.accounts, old.AccountId, newDelta-oldDelta)
+		if err != nil {
+			return err
+		}
+
+		if err := repo.journal.VoidTransaction(sc, old.Id); err != nil {
+			return err
+		}
+		updated := *old
+		updated.Type = transaction.Type
+		updated.Amount = transaction.Amount
+		updated.Description = transaction.Description
+		updated.Counterparty = transaction.Counterparty
+		updated.Date = updateDate
+		updated.Splits = splits
+		if len(splits) > 0 {
+			updated.CategoryId = ""
+		}
+		if transaction.CategoryId != "" {
+			updated.CategoryId = transaction.CategoryId
+			updated.CategoryRuleId = ""
+		}
+		if err := relearnCategory(sc, repo.db, *old, updated); err != nil {
+			return err
+		}
+		return repo.journal.PostTransaction(sc, updated, account.Currency)
+	})
 
-	res, err := repo.coll.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return &pb.UpdateTransactionResp{
 			Status:  "error",
 			Message: "Error updating transaction: " + err.Error(),
 		}, err
 	}
-	if res.ModifiedCount == 0 {
-		return &pb.UpdateTransactionResp{
-			Status:  "error",
-			Message: "Transaction not found",
-		}, nil
-	}
 
 	return &pb.UpdateTransactionResp{
 		Status:  "success",
@@ -99,13 +297,31 @@ func (repo *transactionRepositoryImpl) UpdateTransaction(ctx context.Context, tr
 }
 
 func (repo *transactionRepositoryImpl) DeleteTransaction(ctx context.Context, request *pb.DeleteTransactionReq) (*pb.DeleteTransactionResp, error) {
-	filter := bson.D{
-		{Key: "_id", Value: request.Id},
-		{Key: "user_id", Value: request.UserId},
-		{Key: "deleted_at", Value: time.Now()},
-	}
+	err := withTransaction(ctx, repo.db, func(sc mongo.SessionContext) error {
+		old, err := repo.findTransaction(sc, request.Id, request.UserId, AccountRoleEditor)
+		if err != nil {
+			return err
+		}
 
-	res, err := repo.coll.UpdateOne(ctx, filter, bson.D{{Key: "$set", Value: bson.D{{Key: "deleted_at", Value: nil}}}})
+		// O'tkazma bo'lsa, ikkala tomoni ham birga o'chiriladi
+		legs := []models.GetTransaction{*old}
+		if old.TransferId != "" {
+			legs, err = repo.GetTransferLegs(sc, old.TransferId)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, leg := range legs {
+			if _, err := checkAccountRole(sc, repo.db, leg.AccountId, request.UserId, AccountRoleEditor); err != nil {
+				return err
+			}
+			if err := repo.removeTransaction(sc, leg); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 
 	if err != nil {
 		return &pb.DeleteTransactionResp{
@@ -114,63 +330,285 @@ func (repo *transactionRepositoryImpl) DeleteTransaction(ctx context.Context, re
 		}, err
 	}
 
-	if res.ModifiedCount == 0 {
-		return &pb.DeleteTransactionResp{
-			Status:  "error",
-			Message: "transaction not found",
-		}, nil
-	}
-
 	return &pb.DeleteTransactionResp{
 		Status:  "success",
 		Message: "Transaction deleted successfully",
 	}, nil
 }
 
-func (repo *transactionRepositoryImpl) GetTransaction(ctx context.Context, request *pb.GetTransactionReq) (*pb.GetTransactionResp, error) {
-	filter := bson.D{
-		{Key: "_id", Value: request.Id},
+func (repo *transactionRepositoryImpl) CreateTransfer(ctx context.Context, transfer *pb.CreateTransferReq) (*pb.CreateTransferResp, error) {
+	date, err := parseDate("date", "2006-01-02 15:04:05", transfer.Date)
+	if err != nil {
+		return nil, err
+	}
+	var transferId string
+	err = withTransaction(ctx, repo.db, func(sc mongo.SessionContext) error {
+		transferId, err = repo.transfer(sc, transfer, date)
+		return err
+	})
+
+	if err != nil {
+		return &pb.CreateTransferResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	return &pb.CreateTransferResp{
+		Status:     "success",
+		Message:    "created transfer successfully",
+		TransferId: transferId,
+	}, nil
+}
+
+// transfer ikki hisob orasidagi o'tkazmaning ikkala qismini yaratadi.
+// Sessiya tranzaksiyasi ichida chaqirilishi kerak.
+func (repo *transactionRepositoryImpl) transfer(sc mongo.SessionContext, transfer *pb.CreateTransferReq, date time.Time) (string, error) {
+	if transfer.FromAccountId == transfer.ToAccountId {
+		return "", errs.InvalidField("to_account_id", "transfer accounts must be different")
+	}
+	if transfer.Amount <= 0 {
+		return "", errs.InvalidField("amount", "must be positive")
+	}
+
+	transferId := uuid.NewString()
+	from, err := checkAccountRole(sc, repo.db, transfer.FromAccountId, transfer.UserId, AccountRoleEditor)
+	if err != nil {
+		return "", err
+	}
+	to, err := checkAccountRole(sc, repo.db, transfer.ToAccountId, transfer.UserId, AccountRoleEditor)
+	if err != nil {
+		return "", err
+	}
+
+	rate, err := transferRate(from.Currency, to.Currency, transfer.Rate)
+	if err != nil {
+		return "", err
+	}
+
+	out := models.GetTransaction{
+		Id:          uuid.NewString(),
+		AccountId:   from.ID,
+		UserId:      transfer.UserId,
+		Type:        TransactionTypeTransferOut,
+		Amount:      transfer.Amount,
+		Description: transfer.Description,
+		Date:        date,
+		TransferId:  transferId,
+	}
+	in := out
+	in.Id = uuid.NewString()
+	in.AccountId = to.ID
+	in.Type = TransactionTypeTransferIn
+	in.Amount = money.Convert(transfer.Amount, from.Currency, to.Currency, rate)
+
+	if err := repo.insertTransaction(sc, out, -out.Amount); err != nil {
+		return "", err
+	}
+	if err := repo.insertTransaction(sc, in, in.Amount); err != nil {
+		return "", err
+	}
+	return transferId, nil
+}
+
+// CountCategoryTransactions foydalanuvchining categoryId ga (yoki bo'linishlaridan
+// biri orqali) bog'langan o'chirilmagan tranzaksiyalari soni.
+func (repo *transactionRepositoryImpl) CountCategoryTransactions(ctx context.Context, userId, categoryId string) (int64, error) {
+	return repo.coll.CountDocuments(ctx, bson.D{
+		{Key: "user_id", Value: userId},
+		categoryFilter([]string{categoryId}),
+		{Key: "deleted_at", Value: nil},
+	})
+}
+
+func (repo *transactionRepositoryImpl) GetTransferLegs(ctx context.Context, transferId string) ([]models.GetTransaction, error) {
+	cursor, err := repo.coll.Find(ctx, bson.D{
+		{Key: "transfer_id", Value: transferId},
 		{Key: "deleted_at", Value: nil},
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	var legs []models.GetTransaction
+	if err := cursor.All(ctx, &legs); err != nil {
+		return nil, err
+	}
+	return legs, nil
+}
+
+// findTransaction tranzaksiyani topadi va foydalanuvchining uning hisobidagi
+// roli kamida minRole ekanini tekshiradi.
+func (repo *transactionRepositoryImpl) findTransaction(ctx context.Context, id, userId, minRole string) (*models.GetTransaction, error) {
 	var transaction models.GetTransaction
-	err := repo.coll.FindOne(ctx, filter).Decode(&transaction)
+	err := repo.coll.FindOne(ctx, bson.D{
+		{Key: "_id", Value: id},
+		{Key: "deleted_at", Value: nil},
+	}).Decode(&transaction)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrTransactionNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if _, err := checkAccountRole(ctx, repo.db, transaction.AccountId, userId, minRole); err != nil {
+		return nil, err
+	}
+	return &transaction, nil
+}
+
+// checkReferences tranzaksiya yoziladigan hisobda foydalanuvchi kamida editor
+// ekanini va kategoriya unga tegishli ekanini tekshiradi.
+func (repo *transactionRepositoryImpl) checkReferences(sc mongo.SessionContext, transaction models.GetTransaction) error {
+	if _, err := checkAccountRole(sc, repo.db, transaction.AccountId, transaction.UserId, AccountRoleEditor); err != nil {
+		return err
+	}
+	categoryIds := []string{transaction.CategoryId}
+	for _, split := range transaction.Splits {
+		categoryIds = append(categoryIds, split.CategoryId)
+	}
+	for _, categoryId := range categoryIds {
+		if categoryId == "" {
+			continue
+		}
+		if err := findOwned(sc, repo.db.Collection("categories"), categoryId, transaction.UserId, ErrCategoryNotFound, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertTransaction tranzaksiyani saqlaydi, hisob balansini o'zgartiradi va
+// journal yozuvlarini yaratadi. Sessiya tranzaksiyasi ichida chaqirilishi kerak.
+func (repo *transactionRepositoryImpl) insertTransaction(sc mongo.SessionContext, transaction models.GetTransaction, delta int64) error {
+	doc := bson.D{
+		{Key: "_id", Value: transaction.Id},
+		{Key: "account_id", Value: transaction.AccountId},
+		{Key: "user_id", Value: transaction.UserId},
+		{Key: "category_id", Value: transaction.CategoryId},
+		{Key: "type", Value: transaction.Type},
+		{Key: "amount", Value: transaction.Amount},
+		{Key: "description", Value: transaction.Description},
+		{Key: "date", Value: transaction.Date},
+		{Key: "created_at", Value: time.Now()},
+		{Key: "updated_at", Value: time.Now()},
+		{Key: "deleted_at", Value: nil},
+	}
+	if transaction.TransferId != "" {
+		doc = append(doc, bson.E{Key: "transfer_id", Value: transaction.TransferId})
+	}
+	if len(transaction.Tags) > 0 {
+		doc = append(doc, bson.E{Key: "tags", Value: transaction.Tags})
+	}
+	if transaction.Notes != "" {
+		doc = append(doc, bson.E{Key: "notes", Value: transaction.Notes})
+	}
+	if len(transaction.Splits) > 0 {
+		doc = append(doc, bson.E{Key: "splits", Value: transaction.Splits})
+	}
+	if transaction.IdempotencyKey != "" {
+		doc = append(doc, bson.E{Key: "idempotency_key", Value: transaction.IdempotencyKey})
+	}
+	if transaction.Counterparty != "" {
+		doc = append(doc, bson.E{Key: "counterparty", Value: transaction.Counterparty})
+	}
+	if transaction.CategoryRuleId != "" {
+		doc = append(doc, bson.E{Key: "category_rule_id", Value: transaction.CategoryRuleId})
+	}
 
+	if _, err := repo.coll.InsertOne(sc, doc); err != nil {
+		return err
+	}
+	account, err := adjustBalance(sc, repo.accounts, transaction.AccountId, delta)
+	if err != nil {
+		return err
+	}
+	if err := learnCategory(sc, repo.db, transaction, 1); err != nil {
+		return err
+	}
+	return repo.journal.PostTransaction(sc, transaction, account.Currency)
+}
+
+// removeTransaction tranzaksiyani o'chiradi va uning balans hamda journal
+// ta'sirini bekor qiladi. Sessiya tranzaksiyasi ichida chaqirilishi kerak.
+func (repo *transactionRepositoryImpl) removeTransaction(sc mongo.SessionContext, transaction models.GetTransaction) error {
+	delta, err := signedAmount(transaction.Type, transaction.Amount)
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.coll.UpdateOne(sc, bson.D{{Key: "_id", Value: transaction.Id}}, bson.D{
+		{Key: "$set", Value: bson.D{{Key: "deleted_at", Value: time.Now()}}},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := adjustBalance(sc, repo.accounts, transaction.AccountId, -delta); err != nil {
+		return err
+	}
+	if err := learnCategory(sc, repo.db, transaction, -1); err != nil {
+		return err
+	}
+	return repo.journal.VoidTransaction(sc, transaction.Id)
+}
+
+func (repo *transactionRepositoryImpl) GetTransaction(ctx context.Context, request *pb.GetTransactionReq) (*pb.GetTransactionResp, error) {
+	transaction, err := repo.findTransaction(ctx, request.Id, request.UserId, AccountRoleViewer)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.GetTransactionResp{
-		Id:          transaction.Id,
-		AccountId:   transaction.AccountId,
-		UserId:      transaction.UserId,
-		Type:        transaction.Type,
-		Amount:      transaction.Amount,
-		Description: transaction.Description,
-		Date:        transaction.Date.Format("2006-01-02 15:04:05"),
+		Id:           transaction.Id,
+		AccountId:    transaction.AccountId,
+		UserId:       transaction.UserId,
+		CategoryId:   transaction.CategoryId,
+		Type:         transaction.Type,
+		Amount:       transaction.Amount,
+		Description:  transaction.Description,
+		Date:         transaction.Date.Format("2006-01-02 15:04:05"),
+		TransferId:   transaction.TransferId,
+		Tags:         transaction.Tags,
+		Notes:        transaction.Notes,
+		Attachments:  attachmentsToPb(transaction.Attachments),
+		Splits:       splitsToPb(transaction.Splits),
+		Counterparty: transaction.Counterparty,
 	}, nil
 }
 
 func (repo *transactionRepositoryImpl) GetTransactionsList(ctx context.Context, request *pb.GetTransactionsListReq) (*pb.GetTransactionsListResp, error) {
-	pipeline := createTransactionFilter(request)
-	// Hujjatlarni sanash uchun `pipeline` ni `Aggregate` bilan ishlating
-	countPipeline := append(pipeline, bson.D{{Key: "$count", Value: "totalCount"}})
-	countCursor, err := repo.coll.Aggregate(ctx, countPipeline)
+	accountIds, err := accessibleAccountIds(ctx, repo.db, request.UserId)
 	if err != nil {
 		return nil, err
 	}
-	var countResult []bson.M
-	if err = countCursor.All(ctx, &countResult); err != nil {
+	pipeline, err := createTransactionFilter(request, accountIds)
+	if err != nil {
 		return nil, err
 	}
+	page := transactionListPage(request)
+
 	totalCount := int32(0)
-	if len(countResult) > 0 {
-		totalCount = countResult[0]["totalCount"].(int32)
+	if !page.cursorMode() {
+		// Hujjatlarni sanash uchun `pipeline` ni `Aggregate` bilan ishlating
+		countPipeline := append(mongo.Pipeline{}, pipeline...)
+		countPipeline = append(countPipeline, bson.D{{Key: "$count", Value: "totalCount"}})
+		countCursor, err := repo.coll.Aggregate(ctx, countPipeline)
+		if err != nil {
+			return nil, err
+		}
+		var countResult []bson.M
+		if err = countCursor.All(ctx, &countResult); err != nil {
+			return nil, err
+		}
+		if len(countResult) > 0 {
+			totalCount = countResult[0]["totalCount"].(int32)
+		}
 	}
 
-	// Pagination uchun `skip` va `limit` qo'shing
-	pipeline = append(pipeline, bson.D{{Key: "$skip", Value: (request.Page - 1) * request.Limit}})
-	pipeline = append(pipeline, bson.D{{Key: "$limit", Value: request.Limit}})
+	stages, err := page.stages()
+	if err != nil {
+		return nil, err
+	}
+	pipeline = append(pipeline, stages...)
 
 	cursor, err := repo.coll.Aggregate(ctx, pipeline)
 	if err != nil {
@@ -178,43 +616,122 @@ func (repo *transactionRepositoryImpl) GetTransactionsList(ctx context.Context,
 	}
 	defer cursor.Close(ctx)
 
-	var transactions []*pb.Transaction
-	for cursor.Next(ctx) {
-		var transaction models.GetTransaction
-		if err := cursor.Decode(&transaction); err != nil {
-			return nil, err
-		}
-		transactions = append(transactions, &pb.Transaction{
-			Id:          transaction.Id,
-			AccountId:   transaction.AccountId,
-			UserId:      transaction.UserId,
-			Type:        transaction.Type,
-			Amount:      transaction.Amount,
-			Description: transaction.Description,
-			Date:        transaction.Date.Format("2006-01-02 15:04:05"),
-		})
-	}
-	if err := cursor.Err(); err != nil {
+	var items []models.GetTransaction
+	if err := cursor.All(ctx, &items); err != nil {
 		return nil, err
 	}
+	n, more := page.trim(len(items))
+
+	var transactions []*pb.Transaction
+	for _, transaction := range items[:n] {
+		transactions = append(transactions, transactionToPb(transaction))
+	}
 
 	if len(transactions) == 0 {
-		return nil, mongo.ErrNoDocuments
+		return nil, errs.NotFound("transactions not found")
 	}
 
-	return &pb.GetTransactionsListResp{
+	resp := &pb.GetTransactionsListResp{
 		TotalCount:   int64(totalCount),
 		Transactions: transactions,
 		Page:         request.Page,
 		Limit:        request.Limit,
-	}, nil
+	}
+	if more {
+		last := items[n-1]
+		if page.numeric {
+			resp.NextPageToken = page.nextToken(last.Amount, last.Id)
+		} else {
+			resp.NextPageToken = page.nextToken(last.Date.UnixMilli(), last.Id)
+		}
+	}
+	return resp, nil
+}
+
+func transactionToPb(transaction models.GetTransaction) *pb.Transaction {
+	return &pb.Transaction{
+		Id:           transaction.Id,
+		AccountId:    transaction.AccountId,
+		UserId:       transaction.UserId,
+		CategoryId:   transaction.CategoryId,
+		Type:         transaction.Type,
+		Amount:       transaction.Amount,
+		Description:  transaction.Description,
+		Date:         transaction.Date.Format("2006-01-02 15:04:05"),
+		TransferId:   transaction.TransferId,
+		Tags:         transaction.Tags,
+		Notes:        transaction.Notes,
+		Attachments:  attachmentsToPb(transaction.Attachments),
+		Splits:       splitsToPb(transaction.Splits),
+		Counterparty: transaction.Counterparty,
+	}
 }
 
-func createTransactionFilter(request *pb.GetTransactionsListReq) mongo.Pipeline {
-	var pipeline mongo.Pipeline
-	if request.UserId != "" {
-		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.D{{Key: "user_id", Value: request.UserId}}}})
+func createTransactionFilter(request *pb.GetTransactionsListReq, accountIds bson.A) (mongo.Pipeline, error) {
+	// Foydalanuvchiga ochiq hisoblardagi tranzaksiyalar
+	match := bson.D{
+		{Key: "account_id", Value: bson.D{{Key: "$in", Value: accountIds}}},
+		{Key: "deleted_at", Value: nil},
+	}
+	// $text faqat birinchi $match bosqichida ishlaydi
+	if request.Query != "" {
+		match = append(match, bson.E{Key: "$text", Value: bson.D{{Key: "$search", Value: request.Query}}})
+	}
+	if len(request.AccountIds) > 0 {
+		match = append(match, bson.E{Key: "$and", Value: bson.A{
+			bson.D{{Key: "account_id", Value: bson.D{{Key: "$in", Value: request.AccountIds}}}},
+		}})
+	}
+	if len(request.CategoryIds) > 0 {
+		match = append(match, categoryFilter(request.CategoryIds))
+	}
+	if len(request.Tags) > 0 {
+		match = append(match, bson.E{Key: "tags", Value: bson.D{{Key: "$all", Value: normalizeTags(request.Tags)}}})
+	}
+	if request.Type != "" {
+		match = append(match, bson.E{Key: "type", Value: containsRegex(request.Type)})
+	}
+	if request.Description != "" {
+		match = append(match, bson.E{Key: "description", Value: containsRegex(request.Description)})
+	}
+
+	// amount - eski so'rovlar uchun min_amount bilan bir xil; ikkalasi berilsa
+	// kattasi olinadi, aks holda $gte kaliti ikki marta yoziladi
+	amount := bson.D{}
+	minAmount := request.MinAmount
+	if request.Amount > minAmount {
+		minAmount = request.Amount
+	}
+	if minAmount != 0 {
+		amount = append(amount, bson.E{Key: "$gte", Value: minAmount})
 	}
+	if request.MaxAmount != 0 {
+		amount = append(amount, bson.E{Key: "$lte", Value: request.MaxAmount})
+	}
+	if len(amount) > 0 {
+		match = append(match, bson.E{Key: "amount", Value: amount})
+	}
+
+	date := bson.D{}
+	if request.DateFrom != "" {
+		from, err := parseDate("date_from", "2006-01-02 15:04:05", request.DateFrom)
+		if err != nil {
+			return nil, err
+		}
+		date = append(date, bson.E{Key: "$gte", Value: from})
+	}
+	if request.DateTo != "" {
+		to, err := parseDate("date_to", "2006-01-02 15:04:05", request.DateTo)
+		if err != nil {
+			return nil, err
+		}
+		date = append(date, bson.E{Key: "$lte", Value: to})
+	}
+	if len(date) > 0 {
+		match = append(match, bson.E{Key: "date", Value: date})
+	}
+
+	pipeline := mongo.Pipeline{bson.D{{Key: "$match", Value: match}}}
 	if request.AccountName != "" {
 		pipeline = append(pipeline, bson.D{
 			{Key: "$lookup", Value: bson.D{
@@ -227,10 +744,7 @@ func createTransactionFilter(request *pb.GetTransactionsListReq) mongo.Pipeline
 		pipeline = append(pipeline, bson.D{{Key: "$unwind", Value: "$account"}})
 		pipeline = append(pipeline, bson.D{
 			{Key: "$match", Value: bson.D{
-				{Key: "account.name", Value: bson.D{
-					{Key: "$regex", Value: ".*" + request.AccountName + ".*"},
-					{Key: "$options", Value: "i"},
-				}},
+				{Key: "account.name", Value: containsRegex(request.AccountName)},
 			}},
 		})
 	}
@@ -246,49 +760,35 @@ func createTransactionFilter(request *pb.GetTransactionsListReq) mongo.Pipeline
 		pipeline = append(pipeline, bson.D{{Key: "$unwind", Value: "$category"}})
 		pipeline = append(pipeline, bson.D{
 			{Key: "$match", Value: bson.D{
-				{Key: "category.name", Value: bson.D{
-					{Key: "$regex", Value: ".*" + request.CategoryName + ".*"},
-					{Key: "$options", Value: "i"},
-				}},
+				{Key: "category.name", Value: containsRegex(request.CategoryName)},
 			}},
 		})
 	}
-	if request.Amount != 0 {
-		pipeline = append(pipeline, bson.D{
-			{Key: "$match", Value: bson.D{
-				{Key: "amount", Value: bson.D{
-					{Key: "$gte", Value: request.Amount},
-				}},
-			}},
-		})
+
+	return pipeline, nil
+}
+
+// transactionListPage so'rovdagi saralash maydoni va tartibi bo'yicha sahifa.
+func transactionListPage(request *pb.GetTransactionsListReq) listPage {
+	page := listPage{
+		field: TransactionSortDate,
+		asc:   strings.EqualFold(request.SortOrder, SortOrderAsc),
+		page:  request.Page,
+		limit: request.Limit,
+		token: request.PageToken,
 	}
-	if request.Description != "" {
-		pipeline = append(pipeline, bson.D{
-			{Key: "$match", Value: bson.D{
-				{Key: "description", Value: bson.D{
-					{Key: "$regex", Value: ".*" + request.Description + ".*"},
-					{Key: "$options", Value: "i"},
-				}},
-			}},
-		})
+	if strings.EqualFold(request.SortBy, TransactionSortAmount) {
+		page.field = TransactionSortAmount
+		page.numeric = true
 	}
+	return page
+}
 
-	if request.Type != "" {
-		pipeline = append(pipeline, bson.D{
-			{Key: "$match", Value: bson.D{
-				{Key: "type", Value: bson.D{
-					{Key: "$regex", Value: ".*" + request.Type + ".*"},
-					{Key: "$options", Value: "i"},
-				}},
-			}},
-		})
+// containsRegex qiymatni o'z ichiga olgan satrlar uchun katta-kichik harf farqlanmaydigan
+// $regex. Foydalanuvchi kiritgan maxsus belgilar ekranlanadi.
+func containsRegex(value string) bson.D {
+	return bson.D{
+		{Key: "$regex", Value: regexp.QuoteMeta(value)},
+		{Key: "$options", Value: "i"},
 	}
-
-	pipeline = append(pipeline, bson.D{
-		{Key: "$match", Value: bson.D{
-			{Key: "delete_at", Value: nil},
-		}},
-	})
-
-	return pipeline
 }
@@ -2,10 +2,13 @@ package mongodb
 
 import (
 	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/pkg/errs"
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 // <--- Transactions and budgets are not tested in this test suite, as they are specific to the budget
@@ -20,8 +23,8 @@ func TestCreateTransaction(t *testing.T) {
 	transaction := &pb.CreateTransactionReq{
 		UserId:      "test_user_id",
 		AccountId:   "test_account_id",
-		Amount:      100.0,
-		Type:        "expence",
+		Amount:      10000,
+		Type:        "expense",
 		Description: "Test Transaction",
 		CategoryId:  "test_category",
 		Date:        "2022-01-01 00:00:00",
@@ -43,10 +46,11 @@ func TestUpdateTransaction(t *testing.T) {
 	repo := NewTransactionRepository(db)
 	transaction := &pb.UpdateTransactionReq{
 		Id:          "1e891746-d2bb-44d0-bab0-4660ee52d12d",
-		Amount:      200.0,
+		Amount:      20000,
 		Type:        "income",
 		Description: "Updated Test Transaction",
 		Date:        "2022-01-02 00:00:00",
+		UserId:      "test_user_id",
 	}
 
 	resp, err := repo.UpdateTransaction(context.Background(), transaction)
@@ -69,10 +73,13 @@ func TestDeleteTransaction(t *testing.T) {
 		Id:     "test_transaction_id",
 		UserId: "test_user_id",
 	})
+	if errors.Is(err, ErrTransactionNotFound) {
+		assert.Equal(t, "transaction not found", resp.Message)
+		return
+	}
 	if err != nil {
 		t.Fatal(err)
 	}
-	assert.Equal(t, "transaction not found", resp.Message)
 	assert.Equal(t, "success", resp.Status)
 }
 
@@ -85,7 +92,8 @@ func TestGetTransactions(t *testing.T) {
 
 	repo := NewTransactionRepository(db)
 	resp, err := repo.GetTransactionsList(context.Background(), &pb.GetTransactionsListReq{
-		Type: "income",
+		Type:   "income",
+		UserId: "test_user_id",
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -104,10 +112,37 @@ func TestGetTransaction(t *testing.T) {
     repo := NewTransactionRepository(db)
     resp, err := repo.GetTransaction(context.Background(), &pb.GetTransactionReq{
         Id:     "70ea539d-3099-40fb-bbd9-155f05d9d5e8",
+        UserId: "test_user_id",
     })
     if err!= nil {
         t.Fatal(err)
     }
     assert.NotNil(t, resp)
     assert.Equal(t, "test_user_id", resp.UserId)
-}
\ No newline at end of file
+}
+func TestCreateTransactionFilter(t *testing.T) {
+	pipeline, err := createTransactionFilter(&pb.GetTransactionsListReq{
+		Query:       "coffee",
+		Description: "a.b(",
+		MinAmount:   100,
+		MaxAmount:   500,
+		DateFrom:    "2024-01-01 00:00:00",
+		CategoryIds: []string{"food"},
+	}, bson.A{"acc-1"})
+	assert.NoError(t, err)
+	assert.Len(t, pipeline, 1)
+
+	match := pipeline[0][0].Value.(bson.D).Map()
+	assert.Equal(t, bson.D{{Key: "$search", Value: "coffee"}}, match["$text"])
+	assert.Equal(t, `a\.b\(`, match["description"].(bson.D)[0].Value)
+	assert.Equal(t, bson.D{{Key: "$gte", Value: int64(100)}, {Key: "$lte", Value: int64(500)}}, match["amount"])
+	assert.Nil(t, match["deleted_at"])
+
+	pipeline, err = createTransactionFilter(&pb.GetTransactionsListReq{Amount: 300, MinAmount: 100}, bson.A{})
+	assert.NoError(t, err)
+	match = pipeline[0][0].Value.(bson.D).Map()
+	assert.Equal(t, bson.D{{Key: "$gte", Value: int64(300)}}, match["amount"])
+
+	_, err = createTransactionFilter(&pb.GetTransactionsListReq{DateTo: "31.01.2024"}, bson.A{})
+	assert.Equal(t, errs.KindInvalidArgument, errs.KindOf(err))
+}
@@ -0,0 +1,107 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// splitsFromPb tranzaksiya bo'linishlarini tekshiradi: har biri kategoriyali va
+// musbat bo'lishi, yig'indisi esa tranzaksiya summasiga teng bo'lishi kerak.
+// Bo'lingan tranzaksiyaning o'z kategoriyasi bo'lmaydi.
+func splitsFromPb(splits []*pb.TransactionSplit, amount int64, categoryId string) ([]models.Split, error) {
+	if len(splits) == 0 {
+		return nil, nil
+	}
+	if categoryId != "" {
+		return nil, errs.InvalidField("category_id", "must be empty when splits are given")
+	}
+
+	var total int64
+	result := make([]models.Split, 0, len(splits))
+	for i, split := range splits {
+		if split.CategoryId == "" {
+			return nil, errs.InvalidField(fmt.Sprintf("splits[%d].category_id", i), "is required")
+		}
+		if split.Amount <= 0 {
+			return nil, errs.InvalidField(fmt.Sprintf("splits[%d].amount", i), "must be positive")
+		}
+		total += split.Amount
+		result = append(result, models.Split{
+			CategoryId: split.CategoryId,
+			Amount:     split.Amount,
+			Memo:       split.Memo,
+		})
+	}
+	if total != amount {
+		return nil, errs.InvalidField("splits", "must sum to amount")
+	}
+	return result, nil
+}
+
+func splitsToPb(splits []models.Split) []*pb.TransactionSplit {
+	var result []*pb.TransactionSplit
+	for _, split := range splits {
+		result = append(result, &pb.TransactionSplit{
+			CategoryId: split.CategoryId,
+			Amount:     split.Amount,
+			Memo:       split.Memo,
+		})
+	}
+	return result
+}
+
+// TransactionCategories tranzaksiya summasi tushadigan kategoriyalar (takrorlanmasdan).
+func TransactionCategories(categoryId string, splits []*pb.TransactionSplit) []string {
+	if len(splits) == 0 {
+		if categoryId == "" {
+			return nil
+		}
+		return []string{categoryId}
+	}
+	var result []string
+	seen := map[string]bool{}
+	for _, split := range splits {
+		if !seen[split.CategoryId] {
+			seen[split.CategoryId] = true
+			result = append(result, split.CategoryId)
+		}
+	}
+	return result
+}
+
+// categoryFilter kategoriyasi yoki bo'linishlaridan biri categoryIds ichida bo'lgan tranzaksiyalar.
+func categoryFilter(categoryIds []string) bson.E {
+	return bson.E{Key: "$or", Value: bson.A{
+		bson.D{{Key: "category_id", Value: bson.D{{Key: "$in", Value: categoryIds}}}},
+		bson.D{{Key: "splits.category_id", Value: bson.D{{Key: "$in", Value: categoryIds}}}},
+	}}
+}
+
+// splitLines har bir tranzaksiyani kategoriya qatorlariga ajratadi va faqat
+// categoryIds ga tegishli qatorlarni qoldiradi (categoryIds bo'sh bo'lsa
+// barcha qatorlar). Bo'linmagan tranzaksiya bitta qator bo'ladi; qatorning
+// category_id va amount maydonlari tranzaksiyanikini almashtiradi.
+func splitLines(categoryIds []string) mongo.Pipeline {
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$addFields", Value: bson.D{{Key: "lines", Value: bson.D{{Key: "$cond", Value: bson.A{
+			bson.D{{Key: "$gt", Value: bson.A{bson.D{{Key: "$size", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$splits", bson.A{}}}}}}, 0}}},
+			"$splits",
+			bson.A{bson.D{{Key: "category_id", Value: "$category_id"}, {Key: "amount", Value: "$amount"}}},
+		}}}}}}},
+		bson.D{{Key: "$unwind", Value: "$lines"}},
+	}
+	if len(categoryIds) > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.D{
+			{Key: "lines.category_id", Value: bson.D{{Key: "$in", Value: categoryIds}}},
+		}}})
+	}
+	return append(pipeline, bson.D{{Key: "$set", Value: bson.D{
+		{Key: "category_id", Value: "$lines.category_id"},
+		{Key: "amount", Value: "$lines.amount"},
+	}}})
+}
@@ -0,0 +1,57 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/pkg/errs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestSplitsFromPb(t *testing.T) {
+	splits, err := splitsFromPb([]*pb.TransactionSplit{
+		{CategoryId: "groceries", Amount: 700},
+		{CategoryId: "alcohol", Amount: 300, Memo: "wine"},
+	}, 1000, "")
+	assert.NoError(t, err)
+	assert.Len(t, splits, 2)
+	assert.Equal(t, "wine", splits[1].Memo)
+
+	splits, err = splitsFromPb(nil, 1000, "groceries")
+	assert.NoError(t, err)
+	assert.Nil(t, splits)
+
+	tests := []struct {
+		splits     []*pb.TransactionSplit
+		categoryId string
+	}{
+		{[]*pb.TransactionSplit{{CategoryId: "groceries", Amount: 500}}, ""},
+		{[]*pb.TransactionSplit{{CategoryId: "groceries", Amount: 1000}}, "groceries"},
+		{[]*pb.TransactionSplit{{Amount: 1000}}, ""},
+		{[]*pb.TransactionSplit{{CategoryId: "groceries", Amount: 1100}, {CategoryId: "refund", Amount: -100}}, ""},
+	}
+	for _, tt := range tests {
+		_, err := splitsFromPb(tt.splits, 1000, tt.categoryId)
+		assert.Equal(t, errs.KindInvalidArgument, errs.KindOf(err))
+	}
+}
+
+func TestTransactionCategories(t *testing.T) {
+	assert.Equal(t, []string{"groceries"}, TransactionCategories("groceries", nil))
+	assert.Nil(t, TransactionCategories("", nil))
+	assert.Equal(t, []string{"groceries", "household"}, TransactionCategories("", []*pb.TransactionSplit{
+		{CategoryId: "groceries", Amount: 100},
+		{CategoryId: "household", Amount: 50},
+		{CategoryId: "groceries", Amount: 25},
+	}))
+}
+
+func TestSplitLines(t *testing.T) {
+	stages := splitLines([]string{"food", "groceries"})
+	assert.Len(t, stages, 4)
+	assert.Equal(t, bson.D{{Key: "lines.category_id", Value: bson.D{{Key: "$in", Value: []string{"food", "groceries"}}}}}, stages[2][0].Value)
+
+	// Kategoriyalar berilmasa barcha qatorlar qoladi
+	assert.Len(t, splitLines(nil), 3)
+}
@@ -0,0 +1,172 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/models"
+	"budgeting-service/pkg/errs"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var ErrAttachmentNotFound = errs.NotFound("attachment not found")
+
+// NormalizeTag tegni saqlanadigan ko'rinishga keltiradi: "#Vacation " -> "vacation".
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+}
+
+// normalizeTags teglarni normallashtiradi, bo'sh va takrorlanganlarini olib tashlaydi.
+func normalizeTags(tags []string) []string {
+	var result []string
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	return result
+}
+
+func (repo *transactionRepositoryImpl) AddTransactionTags(ctx context.Context, request *pb.AddTransactionTagsReq) (*pb.AddTransactionTagsResp, error) {
+	tags, err := repo.updateTags(ctx, request.TransactionId, request.UserId, bson.D{
+		{Key: "$addToSet", Value: bson.D{{Key: "tags", Value: bson.D{{Key: "$each", Value: normalizeTags(request.Tags)}}}}},
+	})
+	if err != nil {
+		return &pb.AddTransactionTagsResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	return &pb.AddTransactionTagsResp{
+		Status:  "success",
+		Message: "tags added successfully",
+		Tags:    tags,
+	}, nil
+}
+
+func (repo *transactionRepositoryImpl) RemoveTransactionTags(ctx context.Context, request *pb.RemoveTransactionTagsReq) (*pb.RemoveTransactionTagsResp, error) {
+	tags, err := repo.updateTags(ctx, request.TransactionId, request.UserId, bson.D{
+		{Key: "$pull", Value: bson.D{{Key: "tags", Value: bson.D{{Key: "$in", Value: normalizeTags(request.Tags)}}}}},
+	})
+	if err != nil {
+		return &pb.RemoveTransactionTagsResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	return &pb.RemoveTransactionTagsResp{
+		Status:  "success",
+		Message: "tags removed successfully",
+		Tags:    tags,
+	}, nil
+}
+
+// updateTags teglarni o'zgartirib, tranzaksiyaning yangi teglarini qaytaradi.
+func (repo *transactionRepositoryImpl) updateTags(ctx context.Context, transactionId, userId string, update bson.D) ([]string, error) {
+	if _, err := repo.findTransaction(ctx, transactionId, userId, AccountRoleEditor); err != nil {
+		return nil, err
+	}
+
+	var updated models.GetTransaction
+	err := repo.coll.FindOneAndUpdate(ctx,
+		bson.D{{Key: "_id", Value: transactionId}, {Key: "deleted_at", Value: nil}},
+		update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrTransactionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return updated.Tags, nil
+}
+
+func (repo *transactionRepositoryImpl) AddTransactionAttachment(ctx context.Context, request *pb.AddTransactionAttachmentReq) (*pb.AddTransactionAttachmentResp, error) {
+	attachment := models.Attachment{
+		ID:          uuid.NewString(),
+		FileName:    request.FileName,
+		ContentType: request.ContentType,
+		Size:        request.Size,
+		Checksum:    request.Checksum,
+		StorageKey:  request.StorageKey,
+		CreatedAt:   time.Now(),
+	}
+
+	err := repo.updateAttachments(ctx, request.TransactionId, request.UserId, bson.D{
+		{Key: "$push", Value: bson.D{{Key: "attachments", Value: attachment}}},
+	})
+	if err != nil {
+		return &pb.AddTransactionAttachmentResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	return &pb.AddTransactionAttachmentResp{
+		Status:     "success",
+		Message:    "attachment added successfully",
+		Attachment: attachmentToPb(attachment),
+	}, nil
+}
+
+func (repo *transactionRepositoryImpl) RemoveTransactionAttachment(ctx context.Context, request *pb.RemoveTransactionAttachmentReq) (*pb.RemoveTransactionAttachmentResp, error) {
+	err := repo.updateAttachments(ctx, request.TransactionId, request.UserId, bson.D{
+		{Key: "$pull", Value: bson.D{{Key: "attachments", Value: bson.D{{Key: "_id", Value: request.AttachmentId}}}}},
+	})
+	if err != nil {
+		return &pb.RemoveTransactionAttachmentResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	return &pb.RemoveTransactionAttachmentResp{
+		Status:  "success",
+		Message: "attachment removed successfully",
+	}, nil
+}
+
+// updateAttachments biriktirmalar ro'yxatini o'zgartiradi. Hech narsa o'zgarmasa
+// (masalan, o'chiriladigan biriktirma topilmasa) ErrAttachmentNotFound qaytariladi.
+func (repo *transactionRepositoryImpl) updateAttachments(ctx context.Context, transactionId, userId string, update bson.D) error {
+	if _, err := repo.findTransaction(ctx, transactionId, userId, AccountRoleEditor); err != nil {
+		return err
+	}
+
+	result, err := repo.coll.UpdateOne(ctx, bson.D{{Key: "_id", Value: transactionId}, {Key: "deleted_at", Value: nil}}, update)
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount == 0 {
+		return ErrAttachmentNotFound
+	}
+	return nil
+}
+
+func attachmentToPb(attachment models.Attachment) *pb.Attachment {
+	return &pb.Attachment{
+		Id:          attachment.ID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Checksum:    attachment.Checksum,
+		StorageKey:  attachment.StorageKey,
+		CreatedAt:   attachment.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func attachmentsToPb(attachments []models.Attachment) []*pb.Attachment {
+	var result []*pb.Attachment
+	for _, attachment := range attachments {
+		result = append(result, attachmentToPb(attachment))
+	}
+	return result
+}
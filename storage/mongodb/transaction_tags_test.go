@@ -0,0 +1,42 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/models"
+	"budgeting-service/pkg/exchange"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	assert.Equal(t, []string{"vacation", "reimbursable"}, normalizeTags([]string{"#Vacation", " vacation ", "", "#", "REIMBURSABLE"}))
+	assert.Nil(t, normalizeTags(nil))
+}
+
+func TestTagTotals(t *testing.T) {
+	now := time.Now()
+	transactions := []models.ReportTransaction{
+		{Amount: 300, Currency: "UZS", Date: now, Tags: []string{"vacation", "reimbursable"}},
+		{Amount: 500, Currency: "UZS", Date: now, Tags: []string{"vacation"}},
+		{Amount: 100, Currency: "UZS", Date: now, Tags: []string{"food"}},
+	}
+	table := exchange.NewTable(nil)
+
+	totals, err := tagTotals(transactions, nil, table, "UZS")
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.TagSpending{
+		{Tag: "vacation", Amount: 800, TransactionsCount: 2},
+		{Tag: "reimbursable", Amount: 300, TransactionsCount: 1},
+		{Tag: "food", Amount: 100, TransactionsCount: 1},
+	}, totals)
+
+	totals, err = tagTotals(transactions, []string{"reimbursable"}, table, "UZS")
+	assert.NoError(t, err)
+	assert.Len(t, totals, 1)
+	assert.Equal(t, int64(300), totals[0].Amount)
+
+	_, err = tagTotals(transactions, nil, table, "USD")
+	assert.Error(t, err)
+}
@@ -0,0 +1,126 @@
+package mongodb
+
+import (
+	pb "budgeting-service/generated/budgeting"
+	"budgeting-service/pkg/errs"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Foydalanuvchi asosiy valyutani tanlamagan bo'lsa, hisobotlar shu valyutada bo'ladi
+const DefaultBaseCurrency = "USD"
+
+// Byudjetning necha foizi ishlatilganda ogohlantirish yuboriladi (standart)
+var DefaultBudgetAlertThresholds = []int32{50, 80, 100, 120}
+
+type UserSettingsRepository interface {
+	SetBaseCurrency(ctx context.Context, request *pb.SetBaseCurrencyReq) (*pb.SetBaseCurrencyResp, error)
+	GetBaseCurrency(ctx context.Context, userId string) (string, error)
+	SetBudgetAlertThresholds(ctx context.Context, request *pb.SetBudgetAlertThresholdsReq) (*pb.SetBudgetAlertThresholdsResp, error)
+	GetBudgetAlertThresholds(ctx context.Context, userId string) ([]int32, error)
+}
+
+type userSettingsRepositoryImpl struct {
+	coll *mongo.Collection
+}
+
+func NewUserSettingsRepository(db *mongo.Database) UserSettingsRepository {
+	return &userSettingsRepositoryImpl{coll: db.Collection("user_settings")}
+}
+
+func (repo *userSettingsRepositoryImpl) SetBaseCurrency(ctx context.Context, request *pb.SetBaseCurrencyReq) (*pb.SetBaseCurrencyResp, error) {
+	_, err := repo.coll.UpdateOne(ctx, bson.D{{Key: "_id", Value: request.UserId}}, bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "base_currency", Value: strings.ToUpper(request.BaseCurrency)},
+			{Key: "updated_at", Value: time.Now()},
+		}},
+	}, options.Update().SetUpsert(true))
+
+	if err != nil {
+		return &pb.SetBaseCurrencyResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	return &pb.SetBaseCurrencyResp{
+		Status:  "success",
+		Message: "base currency updated successfully",
+	}, nil
+}
+
+func (repo *userSettingsRepositoryImpl) GetBaseCurrency(ctx context.Context, userId string) (string, error) {
+	var settings struct {
+		BaseCurrency string `bson:"base_currency"`
+	}
+	err := repo.coll.FindOne(ctx, bson.D{{Key: "_id", Value: userId}}).Decode(&settings)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return "", err
+	}
+	if settings.BaseCurrency == "" {
+		return DefaultBaseCurrency, nil
+	}
+	return settings.BaseCurrency, nil
+}
+
+func (repo *userSettingsRepositoryImpl) SetBudgetAlertThresholds(ctx context.Context, request *pb.SetBudgetAlertThresholdsReq) (*pb.SetBudgetAlertThresholdsResp, error) {
+	thresholds, err := normalizeThresholds(request.Thresholds)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = repo.coll.UpdateOne(ctx, bson.D{{Key: "_id", Value: request.UserId}}, bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "budget_alert_thresholds", Value: thresholds},
+			{Key: "updated_at", Value: time.Now()},
+		}},
+	}, options.Update().SetUpsert(true))
+
+	if err != nil {
+		return &pb.SetBudgetAlertThresholdsResp{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	return &pb.SetBudgetAlertThresholdsResp{
+		Status:  "success",
+		Message: "budget alert thresholds updated successfully",
+	}, nil
+}
+
+func (repo *userSettingsRepositoryImpl) GetBudgetAlertThresholds(ctx context.Context, userId string) ([]int32, error) {
+	var settings struct {
+		Thresholds []int32 `bson:"budget_alert_thresholds"`
+	}
+	err := repo.coll.FindOne(ctx, bson.D{{Key: "_id", Value: userId}}).Decode(&settings)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	if settings.Thresholds == nil {
+		return DefaultBudgetAlertThresholds, nil
+	}
+	return settings.Thresholds, nil
+}
+
+// normalizeThresholds foizlarni o'sish tartibida, takrorlarsiz qaytaradi.
+// Bo'sh ro'yxat ogohlantirishlarni o'chiradi.
+func normalizeThresholds(thresholds []int32) ([]int32, error) {
+	result := []int32{}
+	for _, threshold := range thresholds {
+		if threshold <= 0 {
+			return nil, errs.InvalidField("thresholds", fmt.Sprintf("invalid budget alert threshold: %d", threshold))
+		}
+		if !slices.Contains(result, threshold) {
+			result = append(result, threshold)
+		}
+	}
+	slices.Sort(result)
+	return result, nil
+}
@@ -4,6 +4,7 @@ import (
 	"budgeting-service/models"
 	"context"
 	"errors"
+	"strconv"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -27,10 +28,8 @@ func (repo *accountBalanceImpl) SetBalance(ctx context.Context, balance models.B
 }
 
 func (repo *accountBalanceImpl) GetBalance(ctx context.Context, accountId string) (*models.Balance, error) {
-	balance, err := repo.client.Get(ctx, "balance:"+accountId).Float64()
-	if err == redis.Nil {
-		return nil, nil
-	} else if err != nil {
+	balance, ok, err := repo.cachedBalance(ctx, accountId)
+	if err != nil || !ok {
 		return nil, err
 	}
 	return &models.Balance{
@@ -40,12 +39,29 @@ func (repo *accountBalanceImpl) GetBalance(ctx context.Context, accountId string
 }
 
 func (repo *accountBalanceImpl) UpdateBalance(ctx context.Context, newBalance models.Balance) error {
-	balance, err := repo.client.Get(ctx, "balance:"+newBalance.AccountId).Float64()
-	if err == redis.Nil {
-		return errors.New("account not found")
-	} else if err != nil {
+	balance, ok, err := repo.cachedBalance(ctx, newBalance.AccountId)
+	if err != nil {
 		return err
+	} else if !ok {
+		return errors.New("account not found")
 	}
 	newBalance.Balance = balance + newBalance.Balance
 	return repo.client.Set(ctx, "balance:"+newBalance.AccountId, newBalance.Balance, 10*time.Minute).Err()
 }
+
+// cachedBalance keshdagi balansni o'qiydi. Pulni minor birliklarga o'tkazishdan
+// oldin yozilgan kasr qiymatlar ("123.45") o'chiriladi va kesh bo'sh deb
+// hisoblanadi: balans keyingi SetBalance da MongoDB dan qayta yoziladi.
+func (repo *accountBalanceImpl) cachedBalance(ctx context.Context, accountId string) (int64, bool, error) {
+	balance, err := repo.client.Get(ctx, "balance:"+accountId).Int64()
+	var numErr *strconv.NumError
+	switch {
+	case err == redis.Nil:
+		return 0, false, nil
+	case errors.As(err, &numErr):
+		return 0, false, repo.client.Del(ctx, "balance:"+accountId).Err()
+	case err != nil:
+		return 0, false, err
+	}
+	return balance, true, nil
+}
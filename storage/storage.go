@@ -10,12 +10,19 @@ import (
 
 type IStorage interface {
 	AccountRepository() mongodb.AccountRepository
+	AccountMemberRepository() mongodb.AccountMemberRepository
 	TransactionRepository() mongodb.TransactionRepository
+	JournalRepository() mongodb.JournalRepository
 	BudgetManagementRepo() mongodb.BudgetManagementRepo
 	CategoryRepository() mongodb.CategoryRepository
 	GoalsRepository() mongodb.GoalsRepository
 	ReportingRepository() mongodb.ReportingRepository
 	NotificationRepository() mongodb.NotificationRepository
+	ExchangeRateRepository() mongodb.ExchangeRateRepository
+	UserSettingsRepository() mongodb.UserSettingsRepository
+	RecurringTransactionRepository() mongodb.RecurringTransactionRepository
+	BudgetAlertRepository() mongodb.BudgetAlertRepository
+	CategoryRuleRepository() mongodb.CategoryRuleRepository
 	AccountBalance() rdb.AccountBalanceRepository
 }
 
@@ -39,10 +46,18 @@ func (s *storageImpl) AccountRepository() mongodb.AccountRepository {
 	return mongodb.NewAccountRepository(s.mongo)
 }
 
+func (s *storageImpl) AccountMemberRepository() mongodb.AccountMemberRepository {
+	return mongodb.NewAccountMemberRepository(s.mongo)
+}
+
 func (s *storageImpl) TransactionRepository() mongodb.TransactionRepository {
 	return mongodb.NewTransactionRepository(s.mongo)
 }
 
+func (s *storageImpl) JournalRepository() mongodb.JournalRepository {
+	return mongodb.NewJournalRepository(s.mongo)
+}
+
 func (s *storageImpl) BudgetManagementRepo() mongodb.BudgetManagementRepo {
 	return mongodb.NewBudgetManagementRepo(s.mongo)
 }
@@ -62,3 +77,23 @@ func (s *storageImpl) NotificationRepository() mongodb.NotificationRepository {
 func (s *storageImpl) GoalsRepository() mongodb.GoalsRepository {
 	return mongodb.NewGoalsRepository(s.mongo)
 }
+
+func (s *storageImpl) ExchangeRateRepository() mongodb.ExchangeRateRepository {
+	return mongodb.NewExchangeRateRepository(s.mongo)
+}
+
+func (s *storageImpl) UserSettingsRepository() mongodb.UserSettingsRepository {
+	return mongodb.NewUserSettingsRepository(s.mongo)
+}
+
+func (s *storageImpl) RecurringTransactionRepository() mongodb.RecurringTransactionRepository {
+	return mongodb.NewRecurringTransactionRepository(s.mongo)
+}
+
+func (s *storageImpl) BudgetAlertRepository() mongodb.BudgetAlertRepository {
+	return mongodb.NewBudgetAlertRepository(s.mongo)
+}
+
+func (s *storageImpl) CategoryRuleRepository() mongodb.CategoryRuleRepository {
+	return mongodb.NewCategoryRuleRepository(s.mongo)
+}